@@ -0,0 +1,354 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/lib/auth"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &ACLBindingRulePreviewDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLBindingRulePreviewDataSource{}
+
+type ACLBindingRulePreviewDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewACLBindingRulePreviewDataSource() datasource.DataSource {
+	return &ACLBindingRulePreviewDataSource{}
+}
+
+type aclBindingRulePreviewModel struct {
+	AuthMethod   types.String                      `tfsdk:"auth_method"`
+	Claims       types.String                      `tfsdk:"claims"`
+	ValueClaims  types.Map                         `tfsdk:"value_claims"`
+	ListClaims   types.Map                         `tfsdk:"list_claims"`
+	MatchedRules []aclBindingRulePreviewMatchModel `tfsdk:"matched_rules"`
+	Management   types.Bool                        `tfsdk:"management"`
+	Policies     []types.String                    `tfsdk:"policies"`
+	Roles        []aclBindingRulePreviewRoleModel  `tfsdk:"roles"`
+}
+
+type aclBindingRulePreviewMatchModel struct {
+	ID       types.String `tfsdk:"id"`
+	BindType types.String `tfsdk:"bind_type"`
+	BindName types.String `tfsdk:"bind_name"`
+}
+
+type aclBindingRulePreviewRoleModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *ACLBindingRulePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_binding_rule_preview"
+}
+
+func (d *ACLBindingRulePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Preview the roles and policies the binding rules of an ACL auth method grant for a set of claims.",
+		Attributes: map[string]schema.Attribute{
+			"auth_method": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the auth method.",
+			},
+			"claims": schema.StringAttribute{
+				Required:    true,
+				Description: "A JSON document with the claims returned by the identity provider.",
+			},
+			"value_claims": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The claims extracted with the claim_mappings of the auth method, available as value.<name> in selectors.",
+			},
+			"list_claims": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "The claims extracted with the list_claim_mappings of the auth method, available as list.<name> in selectors.",
+			},
+			"matched_rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The binding rules with a selector matching the claims.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the binding rule.",
+						},
+						"bind_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The bind type of the binding rule.",
+						},
+						"bind_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The bind name of the binding rule, interpolated with the claims.",
+						},
+					},
+				},
+			},
+			"management": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the login would return a management token.",
+			},
+			"policies": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the ACL policies the login would grant.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The ACL roles the login would grant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the ACL role.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the ACL role.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ACLBindingRulePreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *ACLBindingRulePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data aclBindingRulePreviewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+	name := data.AuthMethod.ValueString()
+
+	var all map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Claims.ValueString()), &all); err != nil {
+		resp.Diagnostics.AddError("Invalid Claims", fmt.Sprintf("error decoding claims: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ACL Auth Method", map[string]any{"name": name})
+	method, _, err := client.ACLAuthMethods().Get(name, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ACL Auth Method", fmt.Sprintf("error reading %q: %s", name, err))
+		return
+	}
+
+	// The claims are mapped with the same code Nomad runs at login time so
+	// the preview cannot drift from the server.
+	authMethod := &structs.ACLAuthMethod{
+		Name:   name,
+		Config: &structs.ACLAuthMethodConfig{},
+	}
+	if method.Config != nil {
+		authMethod.Config.ClaimMappings = method.Config.ClaimMappings
+		authMethod.Config.ListClaimMappings = method.Config.ListClaimMappings
+	}
+
+	claims, err := auth.SelectorData(authMethod, all, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Claims", err.Error())
+		return
+	}
+	identity := auth.NewIdentity(authMethod.Config, claims)
+
+	tflog.Debug(ctx, "Listing ACL Binding Rules", map[string]any{"auth_method": name})
+	stubs, _, err := client.ACLBindingRules().List(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing ACL Binding Rules", err.Error())
+		return
+	}
+	sort.Slice(stubs, func(i, j int) bool { return stubs[i].ID < stubs[j].ID })
+
+	data.Management = types.BoolValue(false)
+	data.MatchedRules = []aclBindingRulePreviewMatchModel{}
+	data.Policies = []types.String{}
+	data.Roles = []aclBindingRulePreviewRoleModel{}
+
+	seen := make(map[string]bool)
+	for _, stub := range stubs {
+		if stub.AuthMethod != name {
+			continue
+		}
+
+		rule, _, err := client.ACLBindingRules().Get(stub.ID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading ACL Binding Rule", fmt.Sprintf("error reading %q: %s", stub.ID, err))
+			return
+		}
+
+		matches, err := bindingRuleSelectorMatches(rule.Selector, identity.Claims)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Invalid Binding Rule Selector",
+				fmt.Sprintf("The selector of binding rule %q never matches: %s", rule.ID, err),
+			)
+		}
+		if !matches {
+			continue
+		}
+
+		bindName, err := computeBindName(rule.BindType, rule.BindName, identity.ClaimMappings)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error computing bind name",
+				fmt.Sprintf("binding rule %q: %s", rule.ID, err),
+			)
+			return
+		}
+
+		data.MatchedRules = append(data.MatchedRules, aclBindingRulePreviewMatchModel{
+			ID:       types.StringValue(rule.ID),
+			BindType: types.StringValue(rule.BindType),
+			BindName: optionalStringFromAPI(bindName),
+		})
+
+		// Nomad stops at the first management rule, the rules after it
+		// are not evaluated.
+		if rule.BindType == api.ACLBindingRuleBindTypeManagement {
+			data.Management = types.BoolValue(true)
+			data.Policies = []types.String{}
+			data.Roles = []aclBindingRulePreviewRoleModel{}
+			break
+		}
+
+		if seen[rule.BindType+"/"+bindName] {
+			continue
+		}
+		seen[rule.BindType+"/"+bindName] = true
+
+		// As with Nomad, roles and policies that do not exist are not
+		// granted.
+		switch rule.BindType {
+		case api.ACLBindingRuleBindTypePolicy:
+			_, _, err := client.ACLPolicies().Info(bindName, nil)
+			if err != nil {
+				if strings.Contains(err.Error(), "404") {
+					tflog.Debug(ctx, "No ACL Policy matching binding rule", map[string]any{"name": bindName})
+					continue
+				}
+				resp.Diagnostics.AddError("Error reading ACL Policy", fmt.Sprintf("error reading %q: %s", bindName, err))
+				return
+			}
+			data.Policies = append(data.Policies, types.StringValue(bindName))
+
+		case api.ACLBindingRuleBindTypeRole:
+			role, _, err := client.ACLRoles().GetByName(bindName, nil)
+			if err != nil {
+				if strings.Contains(err.Error(), "404") {
+					tflog.Debug(ctx, "No ACL Role matching binding rule", map[string]any{"name": bindName})
+					continue
+				}
+				resp.Diagnostics.AddError("Error reading ACL Role", fmt.Sprintf("error reading %q: %s", bindName, err))
+				return
+			}
+			data.Roles = append(data.Roles, aclBindingRulePreviewRoleModel{
+				ID:   types.StringValue(role.ID),
+				Name: types.StringValue(role.Name),
+			})
+		}
+	}
+
+	valueClaims, diags := types.MapValueFrom(ctx, types.StringType, claims.Value)
+	resp.Diagnostics.Append(diags...)
+	listClaims, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, claims.List)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ValueClaims = valueClaims
+	data.ListClaims = listClaims
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// bindingRuleSelectorMatches reports whether the selector matches the claims
+// the same way Nomad does, an empty selector matches everything. An invalid
+// selector matches nothing and is returned as an error.
+func bindingRuleSelectorMatches(selector string, claims any) (bool, error) {
+	if selector == "" {
+		return true, nil
+	}
+
+	eval, err := bexpr.CreateEvaluator(selector)
+	if err != nil {
+		return false, err
+	}
+
+	// Nomad does not grant the binding when the evaluation fails, for
+	// example when the selector references a claim that is not mapped.
+	result, err := eval.Evaluate(claims)
+	if err != nil {
+		return false, nil
+	}
+	return result, nil
+}
+
+// computeBindName interpolates the claims in the bind name of a binding rule
+// and checks the result is a valid name, as Nomad does at login time.
+func computeBindName(bindType, bindName string, claimMappings map[string]string) (string, error) {
+	bindName, err := auth.InterpolateHIL(bindName, claimMappings, true)
+	if err != nil {
+		return "", err
+	}
+
+	var valid bool
+	switch bindType {
+	case api.ACLBindingRuleBindTypePolicy:
+		valid = structs.ValidPolicyName.MatchString(bindName)
+	case api.ACLBindingRuleBindTypeRole:
+		valid = structs.ValidACLRoleName.MatchString(bindName)
+	case api.ACLBindingRuleBindTypeManagement:
+		valid = true
+	default:
+		return "", fmt.Errorf("unknown binding rule bind type: %s", bindType)
+	}
+	if !valid {
+		return "", fmt.Errorf("computed %q bind name is invalid: %q", bindType, bindName)
+	}
+	return bindName, nil
+}
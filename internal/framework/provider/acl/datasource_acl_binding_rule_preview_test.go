@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestDataSourceACLBindingRulePreview(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceACLBindingRulePreviewConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "value_claims.user", "Alice"),
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "list_claims.roles.#", "2"),
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "matched_rules.#", "2"),
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "management", "false"),
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "policies.0", "tf-provider-preview-alice"),
+					resource.TestCheckResourceAttr("data.nomad_acl_binding_rule_preview.test", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.nomad_acl_binding_rule_preview.test", "roles.0.id",
+						"nomad_acl_role.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceACLBindingRulePreviewConfig = `
+resource "nomad_acl_auth_method" "test" {
+	name              = "tf-provider-acl-binding-rule-preview"
+	type              = "OIDC"
+	token_locality    = "global"
+	token_name_format = "$${auth_method_type}-$${auth_method_name}"
+	max_token_ttl     = "10m0s"
+
+	config {
+		oidc_discovery_url    = "https://uk.auth0.com/"
+		oidc_client_id        = "someclientid"
+		oidc_client_secret    = "someclientsecret-t"
+		bound_audiences       = ["someclientid"]
+		allowed_redirect_uris = ["http://localhost:4649/oidc/callback"]
+		claim_mappings = {
+			"/profile/user" : "user"
+		}
+		list_claim_mappings = {
+			"http://nomad.internal/roles" : "roles"
+		}
+	}
+}
+
+resource "nomad_acl_policy" "test" {
+	name      = "tf-provider-preview-alice"
+	rules_hcl = "namespace \"default\" { policy = \"read\" }"
+}
+
+resource "nomad_acl_role" "test" {
+	name = "tf-provider-preview-engineering"
+
+	policy {
+		name = nomad_acl_policy.test.name
+	}
+}
+
+resource "nomad_acl_binding_rule" "role" {
+	auth_method = nomad_acl_auth_method.test.name
+	selector    = "engineering in list.roles"
+	bind_type   = "role"
+	bind_name   = nomad_acl_role.test.name
+}
+
+resource "nomad_acl_binding_rule" "policy" {
+	auth_method = nomad_acl_auth_method.test.name
+	bind_type   = "policy"
+	bind_name   = "tf-provider-preview-$${value.user}"
+}
+
+resource "nomad_acl_binding_rule" "management" {
+	auth_method = nomad_acl_auth_method.test.name
+	selector    = "admin in list.roles"
+	bind_type   = "management"
+}
+
+data "nomad_acl_binding_rule_preview" "test" {
+	auth_method = nomad_acl_auth_method.test.name
+	claims = jsonencode({
+		profile = {
+			user = "Alice"
+		}
+		"http://nomad.internal/roles" = ["engineering", "support"]
+	})
+
+	depends_on = [
+		nomad_acl_binding_rule.role,
+		nomad_acl_binding_rule.policy,
+		nomad_acl_binding_rule.management,
+	]
+}
+`
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	nomadacl "github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &ACLPolicyCheckDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLPolicyCheckDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ACLPolicyCheckDataSource{}
+
+// aclVariablesOperations are the operations that can be checked on
+// variables, the variables capabilities without deny.
+var aclVariablesOperations = []string{
+	nomadacl.VariablesCapabilityWrite,
+	nomadacl.VariablesCapabilityRead,
+	nomadacl.VariablesCapabilityList,
+	nomadacl.VariablesCapabilityDestroy,
+}
+
+type ACLPolicyCheckDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewACLPolicyCheckDataSource() datasource.DataSource {
+	return &ACLPolicyCheckDataSource{}
+}
+
+type aclPolicyCheckModel struct {
+	ID              types.String             `tfsdk:"id"`
+	Policies        []types.String           `tfsdk:"policies"`
+	RulesHCL        []types.String           `tfsdk:"rules_hcl"`
+	NamespaceChecks []aclNamespaceCheckModel `tfsdk:"namespace_check"`
+	VariableChecks  []aclVariableCheckModel  `tfsdk:"variable_check"`
+	AllAllowed      types.Bool               `tfsdk:"all_allowed"`
+}
+
+type aclNamespaceCheckModel struct {
+	Namespace  types.String `tfsdk:"namespace"`
+	Capability types.String `tfsdk:"capability"`
+	Allowed    types.Bool   `tfsdk:"allowed"`
+}
+
+type aclVariableCheckModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Path      types.String `tfsdk:"path"`
+	Operation types.String `tfsdk:"operation"`
+	Allowed   types.Bool   `tfsdk:"allowed"`
+}
+
+func (d *ACLPolicyCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_policy_check"
+}
+
+func (d *ACLPolicyCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Check which capabilities a set of ACL policies grant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"policies": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The names of ACL policies registered in Nomad to evaluate.",
+			},
+			"rules_hcl": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "ACL policy documents, as HCL or JSON, to evaluate.",
+			},
+			"all_allowed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether all the checks are allowed.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"namespace_check": schema.ListNestedBlock{
+				Description: "A namespace capability to check.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"namespace": schema.StringAttribute{
+							Required:    true,
+							Description: "The namespace to check.",
+						},
+						"capability": schema.StringAttribute{
+							Required:    true,
+							Description: "The capability to check.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(nomad.ACLNamespaceCapabilities()...),
+							},
+						},
+						"allowed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the policies grant the capability.",
+						},
+					},
+				},
+			},
+			"variable_check": schema.ListNestedBlock{
+				Description: "A variable operation to check.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"namespace": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The namespace of the variable. Defaults to \"default\".",
+						},
+						"path": schema.StringAttribute{
+							Required:    true,
+							Description: "The path of the variable.",
+						},
+						"operation": schema.StringAttribute{
+							Required:    true,
+							Description: "The operation to check.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(aclVariablesOperations...),
+							},
+						},
+						"allowed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the policies allow the operation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ACLPolicyCheckDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("policies"),
+			path.MatchRoot("rules_hcl"),
+		),
+	}
+}
+
+func (d *ACLPolicyCheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *ACLPolicyCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data aclPolicyCheckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []string
+	for _, rule := range data.RulesHCL {
+		rules = append(rules, rule.ValueString())
+	}
+
+	// Only the policies registered in Nomad require a request, the rules
+	// given inline are evaluated offline.
+	names := make([]string, 0, len(data.Policies))
+	for _, name := range data.Policies {
+		names = append(names, name.ValueString())
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		tflog.Debug(ctx, "Reading ACL Policy", map[string]any{"name": name})
+		policy, _, err := d.providerConfig.Client().ACLPolicies().Info(name, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading ACL Policy", fmt.Sprintf("error reading %q: %s", name, err))
+			return
+		}
+		rules = append(rules, policy.Rules)
+	}
+
+	if err := checkACLPolicies(rules, &data); err != nil {
+		resp.Diagnostics.AddError("Error checking ACL Policies", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// checkACLPolicies compiles the rules into an ACL and sets the result of each
+// check, and the ID, in data.
+func checkACLPolicies(rules []string, data *aclPolicyCheckModel) error {
+	policies := make([]*nomadacl.Policy, 0, len(rules))
+	for i, rule := range rules {
+		policy, err := nomadacl.Parse(rule, nomadacl.PolicyParseLenient)
+		if err != nil {
+			return fmt.Errorf("error parsing ACL policy %d: %w", i, err)
+		}
+		policies = append(policies, policy)
+	}
+
+	aclObj, err := nomadacl.NewACL(false, policies)
+	if err != nil {
+		return fmt.Errorf("error compiling ACL policies: %w", err)
+	}
+
+	allAllowed := true
+
+	for i, check := range data.NamespaceChecks {
+		allowed := aclObj.AllowNamespaceOperation(check.Namespace.ValueString(), check.Capability.ValueString())
+		data.NamespaceChecks[i].Allowed = types.BoolValue(allowed)
+		allAllowed = allAllowed && allowed
+	}
+
+	for i, check := range data.VariableChecks {
+		namespace := check.Namespace.ValueString()
+		if namespace == "" {
+			namespace = "default"
+		}
+		allowed := aclObj.AllowVariableOperation(namespace, check.Path.ValueString(), check.Operation.ValueString(), nil)
+		data.VariableChecks[i].Namespace = types.StringValue(namespace)
+		data.VariableChecks[i].Allowed = types.BoolValue(allowed)
+		allAllowed = allAllowed && allowed
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(rules, "\x00")))))
+	data.AllAllowed = types.BoolValue(allAllowed)
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoenig/test/must"
+)
+
+func TestCheckACLPolicies(t *testing.T) {
+	rules := []string{
+		`namespace "dev" {
+  policy = "read"
+  variables {
+    path "apps/*" {
+      capabilities = ["read", "list"]
+    }
+  }
+}`,
+		`namespace "dev-*" { capabilities = ["submit-job"] }`,
+	}
+	data := aclPolicyCheckModel{
+		NamespaceChecks: []aclNamespaceCheckModel{
+			{Namespace: types.StringValue("dev"), Capability: types.StringValue("read-job")},
+			{Namespace: types.StringValue("dev"), Capability: types.StringValue("submit-job")},
+			{Namespace: types.StringValue("dev-1"), Capability: types.StringValue("submit-job")},
+		},
+		VariableChecks: []aclVariableCheckModel{
+			{Namespace: types.StringValue("dev"), Path: types.StringValue("apps/web"), Operation: types.StringValue("read")},
+			{Namespace: types.StringValue("dev"), Path: types.StringValue("apps/web"), Operation: types.StringValue("write")},
+			{Namespace: types.StringNull(), Path: types.StringValue("apps/web"), Operation: types.StringValue("read")},
+		},
+	}
+
+	must.NoError(t, checkACLPolicies(rules, &data))
+
+	must.True(t, data.NamespaceChecks[0].Allowed.ValueBool())
+	must.False(t, data.NamespaceChecks[1].Allowed.ValueBool())
+	must.True(t, data.NamespaceChecks[2].Allowed.ValueBool())
+	must.True(t, data.VariableChecks[0].Allowed.ValueBool())
+	must.False(t, data.VariableChecks[1].Allowed.ValueBool())
+	must.False(t, data.VariableChecks[2].Allowed.ValueBool())
+	must.Eq(t, "default", data.VariableChecks[2].Namespace.ValueString())
+	must.False(t, data.AllAllowed.ValueBool())
+	must.NotEq(t, "", data.ID.ValueString())
+}
+
+func TestCheckACLPolicies_invalidRules(t *testing.T) {
+	var data aclPolicyCheckModel
+	err := checkACLPolicies([]string{`namespace "dev" { policy = "admin" }`}, &data)
+	must.ErrorContains(t, err, "error parsing ACL policy 0")
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadACLPolicyCheck_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-nomad-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadACLPolicyCheckConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_acl_policy_check.test", "namespace_check.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.nomad_acl_policy_check.test", "namespace_check.1.allowed", "false"),
+					resource.TestCheckResourceAttr("data.nomad_acl_policy_check.test", "variable_check.0.namespace", "default"),
+					resource.TestCheckResourceAttr("data.nomad_acl_policy_check.test", "variable_check.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.nomad_acl_policy_check.test", "all_allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadACLPolicyCheckConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nomad_acl_policy" "test" {
+  name      = %q
+  rules_hcl = <<EOT
+namespace "default" {
+  policy = "read"
+}
+EOT
+}
+
+data "nomad_acl_policy_check" "test" {
+  policies = [nomad_acl_policy.test.name]
+
+  rules_hcl = [<<EOT
+namespace "default" {
+  variables {
+    path "*" {
+      capabilities = ["read"]
+    }
+  }
+}
+EOT
+  ]
+
+  namespace_check {
+    namespace  = "default"
+    capability = "list-jobs"
+  }
+
+  namespace_check {
+    namespace  = "default"
+    capability = "submit-job"
+  }
+
+  variable_check {
+    path      = "app/config"
+    operation = "read"
+  }
+}
+`, name)
+}
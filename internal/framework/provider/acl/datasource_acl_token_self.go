@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &ACLTokenSelfDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLTokenSelfDataSource{}
+
+type ACLTokenSelfDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewACLTokenSelfDataSource() datasource.DataSource {
+	return &ACLTokenSelfDataSource{}
+}
+
+type aclTokenSelfModel struct {
+	ID                types.String        `tfsdk:"id"`
+	AccessorID        types.String        `tfsdk:"accessor_id"`
+	Name              types.String        `tfsdk:"name"`
+	Type              types.String        `tfsdk:"type"`
+	Policies          []string            `tfsdk:"policies"`
+	Roles             []aclTokenRoleModel `tfsdk:"roles"`
+	Global            types.Bool          `tfsdk:"global"`
+	CreateTime        types.String        `tfsdk:"create_time"`
+	ExpirationTime    types.String        `tfsdk:"expiration_time"`
+	EffectivePolicies []string            `tfsdk:"effective_policies"`
+	EffectiveRules    []string            `tfsdk:"effective_rules"`
+}
+
+func (d *ACLTokenSelfDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_token_self"
+}
+
+func (d *ACLTokenSelfDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the ACL token the provider is configured with.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"accessor_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Non-sensitive identifier for the token.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-friendly name of the ACL token.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the token.",
+			},
+			"policies": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of policy names associated with the token.",
+			},
+			"roles": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The roles that are applied to the token.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the ACL role.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the ACL role.",
+						},
+					},
+				},
+			},
+			"global": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the token is replicated to all regions, or if it will only be used in the region it was created.",
+			},
+			"create_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time the token was created.",
+			},
+			"expiration_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The point after which the token is considered revoked and eligible for destruction.",
+			},
+			"effective_policies": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the policies granted to the token, directly or through its roles.",
+			},
+			"effective_rules": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The raw rules of each of the effective policies, in the same order as effective_policies. They are not merged.",
+			},
+		},
+	}
+}
+
+func (d *ACLTokenSelfDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *ACLTokenSelfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data aclTokenSelfModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading the provider ACL token")
+	token, _, err := client.ACLTokens().Self(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ACL Token", fmt.Sprintf("error reading the provider ACL token: %s", err))
+		return
+	}
+
+	data.Roles = make([]aclTokenRoleModel, 0, len(token.Roles))
+	for _, roleLink := range token.Roles {
+		data.Roles = append(data.Roles, aclTokenRoleModel{
+			ID:   types.StringValue(roleLink.ID),
+			Name: types.StringValue(roleLink.Name),
+		})
+	}
+
+	// Management tokens are not restricted by policies, for other tokens the
+	// policies of their roles are added to the policies linked directly.
+	data.EffectivePolicies = []string{}
+	data.EffectiveRules = []string{}
+	if token.Type != "management" {
+		names := make(map[string]bool)
+		for _, name := range token.Policies {
+			names[name] = true
+		}
+		for _, roleLink := range token.Roles {
+			tflog.Debug(ctx, "Reading ACL Role", map[string]any{"id": roleLink.ID})
+			role, _, err := client.ACLRoles().Get(roleLink.ID, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading ACL Role", fmt.Sprintf("error reading %q: %s", roleLink.ID, err))
+				return
+			}
+			for _, policyLink := range role.Policies {
+				names[policyLink.Name] = true
+			}
+		}
+
+		for name := range names {
+			data.EffectivePolicies = append(data.EffectivePolicies, name)
+		}
+		sort.Strings(data.EffectivePolicies)
+
+		for _, name := range data.EffectivePolicies {
+			tflog.Debug(ctx, "Reading ACL Policy", map[string]any{"name": name})
+			policy, _, err := client.ACLPolicies().Info(name, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading ACL Policy", fmt.Sprintf("error reading %q: %s", name, err))
+				return
+			}
+			data.EffectiveRules = append(data.EffectiveRules, policy.Rules)
+		}
+	}
+
+	data.ID = types.StringValue(token.AccessorID)
+	data.AccessorID = types.StringValue(token.AccessorID)
+	data.Name = types.StringValue(token.Name)
+	data.Type = types.StringValue(token.Type)
+	data.Policies = append([]string{}, token.Policies...)
+	data.Global = types.BoolValue(token.Global)
+	data.CreateTime = helper.FormatTime(token.CreateTime)
+	data.ExpirationTime = types.StringNull()
+	if token.ExpirationTime != nil {
+		data.ExpirationTime = helper.FormatTime(*token.ExpirationTime)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
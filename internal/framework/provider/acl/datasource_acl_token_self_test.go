@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadACLTokenSelf_basic(t *testing.T) {
+	resourceName := "data.nomad_acl_token_self.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nomad_acl_token_self" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "accessor_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "type", "management"),
+					resource.TestCheckResourceAttr(resourceName, "effective_policies.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "effective_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNomadACLTokenSelf_clientToken(t *testing.T) {
+	resourceName := "data.nomad_acl_token_self.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadACLTokenSelfClientConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "accessor_id", "nomad_acl_token.test", "accessor_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-token-self"),
+					resource.TestCheckResourceAttr(resourceName, "type", "client"),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "effective_policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "effective_policies.0", "tf-acc-token-self-direct"),
+					resource.TestCheckResourceAttr(resourceName, "effective_policies.1", "tf-acc-token-self-role"),
+					resource.TestCheckResourceAttr(resourceName, "effective_rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNomadACLTokenSelfClientConfig = `
+resource "nomad_acl_policy" "direct" {
+  name      = "tf-acc-token-self-direct"
+  rules_hcl = "namespace \"default\" { policy = \"read\" }"
+}
+
+resource "nomad_acl_policy" "role" {
+  name      = "tf-acc-token-self-role"
+  rules_hcl = "node { policy = \"read\" }"
+}
+
+resource "nomad_acl_role" "test" {
+  name = "tf-acc-token-self"
+
+  policy {
+    name = nomad_acl_policy.role.name
+  }
+}
+
+resource "nomad_acl_token" "test" {
+  name     = "tf-acc-token-self"
+  type     = "client"
+  policies = [nomad_acl_policy.direct.name]
+
+  role {
+    id = nomad_acl_role.test.id
+  }
+}
+
+provider "nomad" {
+  alias     = "client"
+  secret_id = nomad_acl_token.test.secret_id
+}
+
+data "nomad_acl_token_self" "test" {
+  provider = nomad.client
+}
+`
@@ -0,0 +1,599 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ ephemeral.EphemeralResource = &ACLOIDCLoginEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ACLOIDCLoginEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &ACLOIDCLoginEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ACLOIDCLoginEphemeralResource{}
+
+// oidcLoginTimeout bounds the requests made to the identity provider.
+const oidcLoginTimeout = 30 * time.Second
+
+// oidcLoginPrivateAccessorKey is the private data key holding the accessor ID
+// of the token issued by Open, so Close can delete it.
+const oidcLoginPrivateAccessorKey = "accessor_id"
+
+type ACLOIDCLoginEphemeralResource struct {
+	SDKv2Meta func() any
+}
+
+type aclOIDCLoginEphemeralModel struct {
+	AuthMethod     types.String `tfsdk:"auth_method"`
+	RedirectURI    types.String `tfsdk:"redirect_uri"`
+	ClientNonce    types.String `tfsdk:"client_nonce"`
+	Code           types.String `tfsdk:"code"`
+	State          types.String `tfsdk:"state"`
+	TokenURL       types.String `tfsdk:"token_url"`
+	TokenField     types.String `tfsdk:"token_field"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	Scopes         types.List   `tfsdk:"scopes"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	AccessorID     types.String `tfsdk:"accessor_id"`
+	SecretID       types.String `tfsdk:"secret_id"`
+	Name           types.String `tfsdk:"name"`
+	Policies       types.Set    `tfsdk:"policies"`
+	Roles          types.Set    `tfsdk:"roles"`
+	ExpirationTime types.String `tfsdk:"expiration_time"`
+	Claims         types.Map    `tfsdk:"claims"`
+}
+
+func NewACLOIDCLoginEphemeralResource() ephemeral.EphemeralResource {
+	return &ACLOIDCLoginEphemeralResource{}
+}
+
+func (r *ACLOIDCLoginEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_oidc_login"
+}
+
+func (r *ACLOIDCLoginEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeralschema.Schema{
+		Description: "Logs in to Nomad with an OIDC auth method without user interaction, to verify the configuration of the auth method and its binding rules. The Nomad token is deleted when Terraform no longer needs it.",
+		Attributes: map[string]ephemeralschema.Attribute{
+			"auth_method": ephemeralschema.StringAttribute{
+				Required:    true,
+				Description: "The name of the auth method to log in with.",
+			},
+			"redirect_uri": ephemeralschema.StringAttribute{
+				Required:    true,
+				Description: "The redirect URI of the OIDC authorization code flow. Must be one of the allowed redirect URIs of the auth method.",
+			},
+			"client_nonce": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The client nonce of the OIDC authorization code flow. Generated if not set, must be set with code.",
+			},
+			"code": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "An authorization code obtained outside of Terraform, to complete the OIDC flow with.",
+			},
+			"state": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The state returned with code.",
+			},
+			"token_url": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The token endpoint of the identity provider. When set, a token is requested with the password grant if username is set or with the client credentials grant otherwise, and its access token authenticates the authorization request of the OIDC flow.",
+			},
+			"token_field": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The token of the token endpoint response whose claims are returned in claims, id_token or access_token. Defaults to id_token.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("id_token", "access_token"),
+				},
+			},
+			"client_id": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The client ID to request a token with.",
+			},
+			"client_secret": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client secret to request a token with.",
+			},
+			"scopes": ephemeralschema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The scopes to request a token with.",
+			},
+			"username": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The username of the resource owner. Used with the password grant, or as HTTP basic credentials on the authorization endpoint in the OIDC authorization code flow.",
+			},
+			"password": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the resource owner.",
+			},
+			"accessor_id": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "The accessor ID of the Nomad token.",
+			},
+			"secret_id": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret of the Nomad token.",
+			},
+			"name": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the Nomad token.",
+			},
+			"policies": ephemeralschema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The policies bound to the Nomad token by the binding rules.",
+			},
+			"roles": ephemeralschema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The roles bound to the Nomad token by the binding rules.",
+				NestedObject: ephemeralschema.NestedAttributeObject{
+					Attributes: map[string]ephemeralschema.Attribute{
+						"id": ephemeralschema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the ACL role.",
+						},
+						"name": ephemeralschema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the ACL role.",
+						},
+					},
+				},
+			},
+			"expiration_time": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "The time the Nomad token expires, in RFC3339 format.",
+			},
+			"claims": ephemeralschema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The claims of the token requested from token_url, selected by token_field. Values that are not strings are JSON encoded. Null when token_url is not set: Nomad exchanges the authorization code for the ID token itself and does not return its claims.",
+			},
+		},
+	}
+}
+
+func (r *ACLOIDCLoginEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		&aclOIDCLoginConfigValidator{},
+	}
+}
+
+// aclOIDCLoginConfigValidator validates that the arguments of the login flows
+// are not mixed, and that code comes with the values it was issued for.
+type aclOIDCLoginConfigValidator struct{}
+
+func (v *aclOIDCLoginConfigValidator) Description(_ context.Context) string {
+	return "code and state can't be set with token_url, and code requires client_nonce and state"
+}
+
+func (v *aclOIDCLoginConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *aclOIDCLoginConfigValidator) ValidateEphemeralResource(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data aclOIDCLoginEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TokenURL.IsUnknown() {
+		return
+	}
+
+	switch {
+	case !data.TokenURL.IsNull():
+		for name, value := range map[string]types.String{"code": data.Code, "state": data.State} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid OIDC login configuration", fmt.Sprintf("%s can't be set with token_url.", name))
+			}
+		}
+	case !data.Code.IsNull():
+		if data.ClientNonce.IsNull() || data.State.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("code"), "Invalid OIDC login configuration", "client_nonce and state must be set with code.")
+		}
+	}
+}
+
+func (r *ACLOIDCLoginEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdkv2Meta, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected provider data of type func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.SDKv2Meta = sdkv2Meta
+}
+
+func (r *ACLOIDCLoginEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data aclOIDCLoginEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.SDKv2Meta == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Nomad Provider",
+			"The provider has not been configured. Configure the nomad provider before using nomad_acl_oidc_login.",
+		)
+		return
+	}
+
+	providerData := r.SDKv2Meta()
+	providerConfig, ok := providerData.(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Metadata Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", providerData),
+		)
+		return
+	}
+	client := providerConfig.Client()
+
+	claims := types.MapNull(types.StringType)
+
+	// With token_url, the token requested from the identity provider
+	// authenticates the authorization request, so the identity provider
+	// issues a code without user interaction.
+	var accessToken string
+	if !data.TokenURL.IsNull() {
+		tokens, err := requestIdentityProviderTokens(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Error requesting token from the identity provider", err.Error())
+			return
+		}
+		accessToken = tokens["access_token"]
+
+		field := data.TokenField.ValueString()
+		if field == "" {
+			field = "id_token"
+		}
+		if tokens[field] == "" {
+			resp.Diagnostics.AddError("Error requesting token from the identity provider", fmt.Sprintf("token response has no %s", field))
+			return
+		}
+
+		claimValues, err := decodeJWTClaims(tokens[field])
+		if err != nil {
+			resp.Diagnostics.AddError("Error decoding token claims", err.Error())
+			return
+		}
+		var diags diag.Diagnostics
+		claims, diags = types.MapValueFrom(ctx, types.StringType, claimValues)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if data.ClientNonce.IsNull() {
+		nonce, err := generateClientNonce()
+		if err != nil {
+			resp.Diagnostics.AddError("Error generating client nonce", err.Error())
+			return
+		}
+		data.ClientNonce = types.StringValue(nonce)
+	}
+
+	code, state := data.Code.ValueString(), data.State.ValueString()
+	if data.Code.IsNull() {
+		log.Printf("[DEBUG] nomad_acl_oidc_login: getting auth URL of auth method %q", data.AuthMethod.ValueString())
+		authURL, _, err := client.ACLAuth().GetAuthURL(&api.ACLOIDCAuthURLRequest{
+			AuthMethodName: data.AuthMethod.ValueString(),
+			RedirectURI:    data.RedirectURI.ValueString(),
+			ClientNonce:    data.ClientNonce.ValueString(),
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting OIDC auth URL", err.Error())
+			return
+		}
+
+		code, state, err = authorizeWithIdentityProvider(ctx, authURL.AuthURL, data, accessToken)
+		if err != nil {
+			resp.Diagnostics.AddError("Error authorizing with the identity provider", err.Error())
+			return
+		}
+	}
+
+	log.Printf("[DEBUG] nomad_acl_oidc_login: completing OIDC auth with auth method %q", data.AuthMethod.ValueString())
+	token, _, err := client.ACLAuth().CompleteAuth(&api.ACLOIDCCompleteAuthRequest{
+		AuthMethodName: data.AuthMethod.ValueString(),
+		ClientNonce:    data.ClientNonce.ValueString(),
+		State:          state,
+		Code:           code,
+		RedirectURI:    data.RedirectURI.ValueString(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error completing OIDC auth", err.Error())
+		return
+	}
+	log.Printf("[DEBUG] nomad_acl_oidc_login: logged in as ACL token %q", token.AccessorID)
+
+	result, diags := buildACLTokenResult(ctx, token)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AccessorID = result.AccessorID
+	data.SecretID = result.SecretID
+	data.Name = result.Name
+	data.Policies = result.Policies
+	data.Roles = result.Roles
+	data.ExpirationTime = result.ExpirationTime
+	data.Claims = claims
+
+	accessorJSON, err := json.Marshal(token.AccessorID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, oidcLoginPrivateAccessorKey, accessorJSON)...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the Nomad token issued by Open, so each plan and apply
+// doesn't leave a token behind until it expires.
+func (r *ACLOIDCLoginEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	accessorJSON, diags := req.Private.GetKey(ctx, oidcLoginPrivateAccessorKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || accessorJSON == nil {
+		return
+	}
+
+	var accessor string
+	if err := json.Unmarshal(accessorJSON, &accessor); err != nil {
+		resp.Diagnostics.AddError("Error decoding private data", err.Error())
+		return
+	}
+
+	providerConfig, ok := r.SDKv2Meta().(nomad.ProviderConfig)
+	if !ok {
+		return
+	}
+
+	log.Printf("[DEBUG] nomad_acl_oidc_login: deleting ACL token %q", accessor)
+	if _, err := providerConfig.Client().ACLTokens().Delete(accessor, nil); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error deleting ACL token",
+			fmt.Sprintf("The token %q issued by the OIDC login could not be deleted, it stays valid until it expires: %s", accessor, err),
+		)
+	}
+}
+
+// requestIdentityProviderTokens requests tokens from the token endpoint of
+// the identity provider with the password grant if a username is set, or with
+// the client credentials grant otherwise, and returns them by name.
+func requestIdentityProviderTokens(ctx context.Context, data aclOIDCLoginEphemeralModel) (map[string]string, error) {
+	form := url.Values{}
+	if data.Username.IsNull() {
+		form.Set("grant_type", "client_credentials")
+	} else {
+		form.Set("grant_type", "password")
+		form.Set("username", data.Username.ValueString())
+		form.Set("password", data.Password.ValueString())
+	}
+	if !data.ClientID.IsNull() {
+		form.Set("client_id", data.ClientID.ValueString())
+	}
+	if !data.ClientSecret.IsNull() {
+		form.Set("client_secret", data.ClientSecret.ValueString())
+	}
+
+	var scopes []string
+	for _, scope := range data.Scopes.Elements() {
+		if s, ok := scope.(types.String); ok {
+			scopes = append(scopes, s.ValueString())
+		}
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oidcLoginTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, data.TokenURL.ValueString(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	log.Printf("[DEBUG] nomad_acl_oidc_login: requesting token with the %s grant", form.Get("grant_type"))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response code %d: %s", resp.StatusCode, body)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("error decoding token response: %w", err)
+	}
+
+	tokens := make(map[string]string)
+	for _, name := range []string{"id_token", "access_token"} {
+		if token, ok := raw[name].(string); ok {
+			tokens[name] = token
+		}
+	}
+	return tokens, nil
+}
+
+// authorizeWithIdentityProvider follows the redirects of the authorization
+// URL until the identity provider redirects to the redirect URI, and returns
+// the code and state of the redirect. This only works with identity
+// providers that authorize the request without user interaction, using the
+// access token as a bearer token if it is set, or HTTP basic authentication.
+func authorizeWithIdentityProvider(ctx context.Context, authURL string, data aclOIDCLoginEphemeralModel, accessToken string) (string, string, error) {
+	redirectURI := data.RedirectURI.ValueString()
+	parsedRedirectURI, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing redirect_uri: %w", err)
+	}
+	parsedAuthURL, err := url.Parse(authURL)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing the authorization URL: %w", err)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	var redirect *url.URL
+	errRedirected := errors.New("redirected")
+	httpClient := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if sameURLPath(req.URL, parsedRedirectURI) {
+				redirect = req.URL
+				return errRedirected
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			// The credentials are only sent to the host of the
+			// authorization URL, never to the other hosts the identity
+			// provider redirects to.
+			req.Header.Del("Authorization")
+			if req.URL.Scheme == parsedAuthURL.Scheme && req.URL.Host == parsedAuthURL.Host {
+				setAuthorization(req, data, accessToken)
+			}
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oidcLoginTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, authURL, nil)
+	if err != nil {
+		return "", "", err
+	}
+	setAuthorization(req, data, accessToken)
+
+	log.Printf("[DEBUG] nomad_acl_oidc_login: requesting authorization from the identity provider")
+	resp, err := httpClient.Do(req)
+	if err != nil && !errors.Is(err, errRedirected) {
+		return "", "", err
+	}
+	if resp != nil {
+		resp.Body.Close()
+	}
+	if redirect == nil {
+		return "", "", fmt.Errorf("the identity provider did not redirect to %s, it may require user interaction (last response code %d)", redirectURI, resp.StatusCode)
+	}
+
+	query := redirect.Query()
+	if errCode := query.Get("error"); errCode != "" {
+		return "", "", fmt.Errorf("the identity provider returned %s: %s", errCode, query.Get("error_description"))
+	}
+	code := query.Get("code")
+	if code == "" {
+		return "", "", fmt.Errorf("the identity provider redirected to %s without a code", redirectURI)
+	}
+	return code, query.Get("state"), nil
+}
+
+// sameURLPath returns whether u has the scheme, host and path of target,
+// ignoring the query.
+func sameURLPath(u, target *url.URL) bool {
+	return u.Scheme == target.Scheme && u.Host == target.Host && u.Path == target.Path
+}
+
+func setAuthorization(req *http.Request, data aclOIDCLoginEphemeralModel, accessToken string) {
+	switch {
+	case accessToken != "":
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	case !data.Username.IsNull():
+		req.SetBasicAuth(data.Username.ValueString(), data.Password.ValueString())
+	}
+}
+
+// decodeJWTClaims returns the claims of a JWT, without verifying it since
+// Nomad verifies the token when logging in.
+func decodeJWTClaims(token string) (map[string]string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding JWT payload: %w", err)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, fmt.Errorf("error decoding JWT claims: %w", err)
+	}
+
+	claims := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			claims[k] = s
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding claim %q: %w", k, err)
+		}
+		claims[k] = string(encoded)
+	}
+	return claims, nil
+}
+
+func generateClientNonce() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoenig/test/must"
+)
+
+func TestSameURLPath(t *testing.T) {
+	target, err := url.Parse("https://app.example.com/callback")
+	must.NoError(t, err)
+
+	cases := []struct {
+		url      string
+		expected bool
+	}{
+		{"https://app.example.com/callback?code=a&state=b", true},
+		{"https://app.example.com/callbackevil?code=a", false},
+		{"https://app.example.com/callback/evil?code=a", false},
+		{"http://app.example.com/callback?code=a", false},
+		{"https://app.example.com.evil.com/callback?code=a", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.url, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, sameURLPath(u, target))
+		})
+	}
+}
+
+func TestAuthorizeWithIdentityProvider_credentialsNotForwarded(t *testing.T) {
+	var otherHostAuthorization string
+	otherHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherHostAuthorization = r.Header.Get("Authorization")
+		http.Redirect(w, r, r.URL.Query().Get("back"), http.StatusFound)
+	}))
+	defer otherHost.Close()
+
+	var authorizeAuthorization []string
+	var idp *httptest.Server
+	idp = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizeAuthorization = append(authorizeAuthorization, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/authorize":
+			http.Redirect(w, r, otherHost.URL+"/?back="+url.QueryEscape(idp.URL+"/consent"), http.StatusFound)
+		case "/consent":
+			http.Redirect(w, r, "https://app.example.com/callback?code=the-code&state=the-state", http.StatusFound)
+		}
+	}))
+	defer idp.Close()
+
+	data := aclOIDCLoginEphemeralModel{
+		RedirectURI: types.StringValue("https://app.example.com/callback"),
+		Username:    types.StringNull(),
+	}
+	code, state, err := authorizeWithIdentityProvider(t.Context(), idp.URL+"/authorize", data, "access-token")
+	must.NoError(t, err)
+	must.Eq(t, "the-code", code)
+	must.Eq(t, "the-state", state)
+	must.Eq(t, "", otherHostAuthorization)
+	must.Eq(t, []string{"Bearer access-token", "Bearer access-token"}, authorizeAuthorization)
+}
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccEphemeralACLOIDCLogin_clientCredentials(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-nomad-test")
+	issuer := testIdentityProvider(t, "nomad", map[string]any{
+		"sub":    "terraform",
+		"groups": []string{"engineering"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralACLOIDCLoginAuthMethodConfig(name, issuer),
+			},
+			{
+				Config: testAccEphemeralACLOIDCLoginAuthMethodConfig(name, issuer) + fmt.Sprintf(`
+ephemeral "nomad_acl_oidc_login" "test" {
+  auth_method   = nomad_acl_auth_method.test.name
+  redirect_uri  = %q
+  token_url     = "%s/token"
+  client_id     = "terraform"
+  client_secret = "secret"
+}
+
+provider "echo" {
+  data = ephemeral.nomad_acl_oidc_login.test
+}
+
+resource "echo" "test" {}
+`, testOIDCRedirectURI, issuer),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("secret_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("policies"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact(name)}),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("claims").AtMapKey("groups"),
+						knownvalue.StringExact(`["engineering"]`),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testOIDCRedirectURI = "http://localhost:4649/oidc/callback"
+
+func testAccEphemeralACLOIDCLoginAuthMethodConfig(name, issuer string) string {
+	return fmt.Sprintf(`
+resource "nomad_acl_auth_method" "test" {
+  name           = %[1]q
+  type           = "OIDC"
+  token_locality = "local"
+  max_token_ttl  = "10m"
+
+  config {
+    oidc_discovery_url    = %[2]q
+    oidc_client_id        = "nomad"
+    oidc_client_secret    = "secret"
+    oidc_disable_userinfo = true
+    bound_audiences       = ["nomad"]
+    allowed_redirect_uris = [%[3]q]
+    list_claim_mappings = {
+      groups = "groups"
+    }
+  }
+}
+
+resource "nomad_acl_binding_rule" "test" {
+  auth_method = nomad_acl_auth_method.test.name
+  selector    = "\"engineering\" in list.groups"
+  bind_type   = "policy"
+  bind_name   = %[1]q
+}
+`, name, issuer, testOIDCRedirectURI)
+}
+
+// testIdentityProvider starts an OIDC identity provider that authorizes the
+// requests authenticated with one of its access tokens without user
+// interaction, and issues ID tokens with the given claims. It returns the
+// issuer URL of the identity provider.
+func testIdentityProvider(t *testing.T, clientID string, claims map[string]any) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	var (
+		lock   sync.Mutex
+		nonces = map[string]string{}
+	)
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	signToken := func(audience, nonce string) string {
+		payload := map[string]any{
+			"iss": srv.URL,
+			"aud": audience,
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(5 * time.Minute).Unix(),
+		}
+		if nonce != "" {
+			payload["nonce"] = nonce
+		}
+		for k, v := range claims {
+			payload[k] = v
+		}
+
+		header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": "test"})
+		body, _ := json.Marshal(payload)
+		signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                srv.URL,
+			"authorization_endpoint":                srv.URL + "/authorize",
+			"token_endpoint":                        srv.URL + "/token",
+			"jwks_uri":                              srv.URL + "/keys",
+			"response_types_supported":              []string{"code"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"kid": "test",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		code := acctest.RandString(16)
+		lock.Lock()
+		nonces[code] = r.URL.Query().Get("nonce")
+		lock.Unlock()
+
+		redirect, _ := url.Parse(r.URL.Query().Get("redirect_uri"))
+		query := redirect.Query()
+		query.Set("code", code)
+		query.Set("state", r.URL.Query().Get("state"))
+		redirect.RawQuery = query.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		audience, nonce := r.Form.Get("client_id"), ""
+		if r.Form.Get("grant_type") == "authorization_code" {
+			lock.Lock()
+			nonce = nonces[r.Form.Get("code")]
+			delete(nonces, r.Form.Get("code"))
+			lock.Unlock()
+			audience = clientID
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"id_token":     signToken(audience, nonce),
+		})
+	})
+
+	return srv.URL
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -68,6 +69,9 @@ func (r *ACLBindingRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"selector": schema.StringAttribute{
 				Optional:    true,
 				Description: "A boolean expression that matches against verified identity attributes returned from the auth method during login.",
+				Validators: []validator.String{
+					bindingRuleSelectorValidator{},
+				},
 			},
 			"bind_type": schema.StringAttribute{
 				Required:    true,
@@ -300,3 +304,36 @@ func validateBindingRule(m aclBindingRuleModel) error {
 	}
 	return nil
 }
+
+// bindingRuleSelectorValidator checks that the selector can be parsed with the
+// go-bexpr grammar Nomad uses to evaluate it at login time. The server
+// accepts invalid selectors but they never match, leaving users without roles
+// or policies.
+type bindingRuleSelectorValidator struct{}
+
+func (v bindingRuleSelectorValidator) Description(_ context.Context) string {
+	return "value must be a valid binding rule selector expression"
+}
+
+func (v bindingRuleSelectorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v bindingRuleSelectorValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	selector := req.ConfigValue.ValueString()
+	if selector == "" {
+		return
+	}
+
+	if _, err := bexpr.CreateEvaluator(selector); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Binding Rule Selector",
+			fmt.Sprintf("The selector %q is not a valid expression: %s", selector, err),
+		)
+	}
+}
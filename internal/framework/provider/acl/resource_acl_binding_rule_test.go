@@ -6,6 +6,7 @@ package acl_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -57,6 +58,29 @@ func TestResourceACLManagementBindingRule(t *testing.T) {
 	})
 }
 
+func TestResourceACLBindingRule_invalidSelector(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nomad_acl_binding_rule" "test" {
+	auth_method = "tf-provider-acl-binding-rule-test-auth-method"
+	selector    = "engineering in list.roles and"
+	bind_type   = "role"
+	bind_name   = "engineering-read-only"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Binding Rule Selector"),
+			},
+		},
+	})
+}
+
 func testResourceACLBindingRuleConfig(description, bindingName string) string {
 	return fmt.Sprintf(`
 resource "nomad_acl_auth_method" "test" {
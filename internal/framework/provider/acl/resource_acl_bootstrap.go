@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource              = &ACLBootstrapResource{}
+	_ resource.ResourceWithConfigure = &ACLBootstrapResource{}
+)
+
+// aclBootstrapDoneRegexp matches the error Nomad returns when the ACL system
+// has already been bootstrapped, with the index to write to the
+// acl-bootstrap-reset file to bootstrap it again.
+var aclBootstrapDoneRegexp = regexp.MustCompile(`ACL bootstrap already done \(reset index: (\d+)\)`)
+
+type ACLBootstrapResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewACLBootstrapResource() resource.Resource {
+	return &ACLBootstrapResource{}
+}
+
+type aclBootstrapModel struct {
+	ID                types.String `tfsdk:"id"`
+	BootstrapSecret   types.String `tfsdk:"bootstrap_secret"`
+	BootstrapSecretWO types.String `tfsdk:"bootstrap_secret_wo"`
+	Bootstrapped      types.Bool   `tfsdk:"bootstrapped"`
+	ResetIndex        types.Int64  `tfsdk:"reset_index"`
+	AccessorID        types.String `tfsdk:"accessor_id"`
+	SecretID          types.String `tfsdk:"secret_id"`
+}
+
+func (r *ACLBootstrapResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_bootstrap"
+}
+
+func (r *ACLBootstrapResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	useStateForUnknown := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Bootstraps the ACL system of a Nomad cluster and returns the initial management token. A cluster that is already bootstrapped is not an error.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The accessor ID of the bootstrap token, or \"bootstrapped\" when the cluster was already bootstrapped.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"bootstrap_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret ID to use for the management token, instead of a generated one.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("bootstrap_secret_wo")),
+				},
+			},
+			"bootstrap_secret_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Description: "The secret ID to use for the management token, instead of a generated one. It is not stored in the Terraform state and secret_id is not set.",
+			},
+			"bootstrapped": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this resource bootstrapped the ACL system, false if it was already bootstrapped.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reset_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The index to write to the acl-bootstrap-reset file of the leader to bootstrap the ACL system again, set when it was already bootstrapped.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"accessor_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The accessor ID of the bootstrap token.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"secret_id": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The secret ID of the bootstrap token.",
+				PlanModifiers: useStateForUnknown,
+			},
+		},
+	}
+}
+
+func (r *ACLBootstrapResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *ACLBootstrapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data aclBootstrapModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config aclBootstrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := data.BootstrapSecret.ValueString()
+	writeOnly := !config.BootstrapSecretWO.IsNull()
+	if writeOnly {
+		secret = config.BootstrapSecretWO.ValueString()
+	}
+
+	client := r.providerConfig.Client()
+
+	tflog.Debug(ctx, "Bootstrapping the ACL system")
+	token, _, err := client.ACLTokens().BootstrapOpts(secret, nil)
+	if err != nil {
+		resetIndex, done := parseACLBootstrapDone(err)
+		if !done {
+			resp.Diagnostics.AddError("Error bootstrapping the ACL system", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "The ACL system is already bootstrapped", map[string]any{"reset_index": resetIndex})
+
+		data.ID = types.StringValue("bootstrapped")
+		data.Bootstrapped = types.BoolValue(false)
+		data.ResetIndex = types.Int64Value(resetIndex)
+		data.AccessorID = types.StringNull()
+		data.SecretID = types.StringNull()
+
+		// The cluster may have been bootstrapped with the given secret
+		// before, for example by a previous run that lost its state.
+		if secret != "" {
+			token, _, err := client.ACLTokens().Self(&api.QueryOptions{AuthToken: secret})
+			if err != nil {
+				tflog.Debug(ctx, "The bootstrap secret is not a valid token", map[string]any{"error": err.Error()})
+			} else if token.Type == "management" {
+				data.ID = types.StringValue(token.AccessorID)
+				data.AccessorID = types.StringValue(token.AccessorID)
+				if !writeOnly {
+					data.SecretID = types.StringValue(token.SecretID)
+				}
+			}
+		}
+
+		if data.AccessorID.IsNull() {
+			resp.Diagnostics.AddWarning(
+				"ACL System Already Bootstrapped",
+				fmt.Sprintf("The ACL system was already bootstrapped, the reset index is %d. The bootstrap token is only available when the bootstrap secret is its secret ID.", resetIndex),
+			)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	tflog.Debug(ctx, "Bootstrapped the ACL system", map[string]any{"accessor_id": token.AccessorID})
+
+	data.ID = types.StringValue(token.AccessorID)
+	data.Bootstrapped = types.BoolValue(true)
+	data.ResetIndex = types.Int64Null()
+	data.AccessorID = types.StringValue(token.AccessorID)
+	data.SecretID = types.StringValue(token.SecretID)
+	if writeOnly {
+		data.SecretID = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLBootstrapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data aclBootstrapModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the existence of the bootstrap token is checked, the cluster
+	// cannot be unbootstrapped.
+	accessor := data.AccessorID.ValueString()
+	if accessor == "" {
+		return
+	}
+
+	// The provider may be configured without a token when it bootstraps the
+	// cluster, so the bootstrap token is used when it is known.
+	var q *api.QueryOptions
+	if secret := data.SecretID.ValueString(); secret != "" {
+		q = &api.QueryOptions{AuthToken: secret}
+	}
+
+	tflog.Debug(ctx, "Reading ACL bootstrap token", map[string]any{"accessor_id": accessor})
+	_, _, err := r.providerConfig.Client().ACLTokens().Info(accessor, q)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "404"):
+			resp.State.RemoveResource(ctx)
+		case strings.Contains(err.Error(), "403"):
+			tflog.Debug(ctx, "Not allowed to read ACL bootstrap token", map[string]any{"accessor_id": accessor})
+		default:
+			resp.Diagnostics.AddError("Error reading ACL bootstrap token", fmt.Sprintf("error reading %q: %s", accessor, err))
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLBootstrapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data aclBootstrapModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the bootstrap secret does not bootstrap the cluster again,
+	// the token issued by the bootstrap is kept.
+	var state aclBootstrapModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = state.ID
+	data.Bootstrapped = state.Bootstrapped
+	data.ResetIndex = state.ResetIndex
+	data.AccessorID = state.AccessorID
+	data.SecretID = state.SecretID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLBootstrapResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The ACL system cannot be unbootstrapped and the management token is
+	// not deleted, so the resource is only removed from the state.
+	tflog.Debug(ctx, "Removing ACL bootstrap from state")
+}
+
+// parseACLBootstrapDone returns the reset index from the error Nomad returns
+// when the ACL system has already been bootstrapped, and whether err is that
+// error.
+func parseACLBootstrapDone(err error) (int64, bool) {
+	m := aclBootstrapDoneRegexp.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0, false
+	}
+	resetIndex, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return resetIndex, true
+}
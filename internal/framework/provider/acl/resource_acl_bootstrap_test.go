@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+// The test cluster is bootstrapped before the tests run, so the resource
+// reports the reset index instead of a token.
+func TestResourceACLBootstrap_alreadyBootstrapped(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "nomad_acl_bootstrap" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_acl_bootstrap.test", "id", "bootstrapped"),
+					resource.TestCheckResourceAttr("nomad_acl_bootstrap.test", "bootstrapped", "false"),
+					resource.TestMatchResourceAttr("nomad_acl_bootstrap.test", "reset_index", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckNoResourceAttr("nomad_acl_bootstrap.test", "accessor_id"),
+					resource.TestCheckNoResourceAttr("nomad_acl_bootstrap.test", "secret_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,436 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource                     = &ACLTokenCleanupResource{}
+	_ resource.ResourceWithConfigure        = &ACLTokenCleanupResource{}
+	_ resource.ResourceWithConfigValidators = &ACLTokenCleanupResource{}
+)
+
+type ACLTokenCleanupResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewACLTokenCleanupResource() resource.Resource {
+	return &ACLTokenCleanupResource{}
+}
+
+type aclTokenCleanupModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Filter             types.String `tfsdk:"filter"`
+	Expired            types.Bool   `tfsdk:"expired"`
+	NameRegex          types.String `tfsdk:"name_regex"`
+	CreatedBefore      types.String `tfsdk:"created_before"`
+	DryRun             types.Bool   `tfsdk:"dry_run"`
+	Concurrency        types.Int64  `tfsdk:"concurrency"`
+	PerPage            types.Int64  `tfsdk:"per_page"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	MatchedAccessorIDs types.List   `tfsdk:"matched_accessor_ids"`
+	DeletedAccessorIDs types.List   `tfsdk:"deleted_accessor_ids"`
+}
+
+func (r *ACLTokenCleanupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_token_cleanup"
+}
+
+func (r *ACLTokenCleanupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplaceString := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Deletes the ACL tokens that match all the given selectors. The cleanup runs when the resource is created and again whenever any of its arguments or triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:      true,
+				Description:   "An expression used to select the tokens, using the same syntax as the nomad_acl_tokens data source.",
+				PlanModifiers: requiresReplaceString,
+			},
+			"expired": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only select the tokens that expired.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Only select the tokens with a name matching this regular expression.",
+				PlanModifiers: requiresReplaceString,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"created_before": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Only select the tokens created before this time, in RFC3339 format.",
+				PlanModifiers: requiresReplaceString,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Only report the tokens that would be deleted in matched_accessor_ids, without deleting them.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(4),
+				Description: "The maximum number of tokens deleted at the same time. Defaults to 4.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 64),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"per_page": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(500),
+				Description: "The number of tokens to request per page when listing them. Defaults to 500.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, will run the cleanup again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"matched_accessor_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The accessor IDs of the tokens that matched the selectors.",
+			},
+			"deleted_accessor_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The accessor IDs of the tokens that were deleted, empty in dry run mode.",
+			},
+		},
+	}
+}
+
+func (r *ACLTokenCleanupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("filter"),
+			path.MatchRoot("expired"),
+			path.MatchRoot("name_regex"),
+			path.MatchRoot("created_before"),
+		),
+	}
+}
+
+func (r *ACLTokenCleanupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *ACLTokenCleanupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data aclTokenCleanupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.providerConfig.Client()
+
+	matched, err := selectACLTokens(ctx, client, data, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Error selecting ACL tokens", err.Error())
+		return
+	}
+
+	deleted := []string{}
+	if !data.DryRun.ValueBool() {
+		var errs []error
+		deleted, errs = deleteACLTokens(ctx, client, matched, int(data.Concurrency.ValueInt64()))
+		for _, err := range errs {
+			resp.Diagnostics.AddError("Error deleting ACL token", err.Error())
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	matchedList, diags := types.ListValueFrom(ctx, types.StringType, matched)
+	resp.Diagnostics.Append(diags...)
+	data.MatchedAccessorIDs = matchedList
+
+	deletedList, diags := types.ListValueFrom(ctx, types.StringType, deleted)
+	resp.Diagnostics.Append(diags...)
+	data.DeletedAccessorIDs = deletedList
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op: the resource records a cleanup that already happened, so
+// there is nothing in Nomad to refresh it against.
+func (r *ACLTokenCleanupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data aclTokenCleanupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never reached since every argument requires replacement, the
+// planned values are stored as they are.
+func (r *ACLTokenCleanupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data aclTokenCleanupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, deleted tokens cannot be
+// restored.
+func (r *ACLTokenCleanupResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// selectACLTokens lists the tokens page by page and returns the accessor IDs
+// of the ones matching all the selectors. The token the provider runs as is
+// never selected.
+func selectACLTokens(ctx context.Context, client *api.Client, data aclTokenCleanupModel, now time.Time) ([]string, error) {
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		if nameRegex, err = regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			return nil, fmt.Errorf("invalid name_regex: %w", err)
+		}
+	}
+
+	var createdBefore time.Time
+	if !data.CreatedBefore.IsNull() {
+		var err error
+		if createdBefore, err = time.Parse(time.RFC3339, data.CreatedBefore.ValueString()); err != nil {
+			return nil, fmt.Errorf("invalid created_before: %w", err)
+		}
+	}
+
+	self, _, err := client.ACLTokens().Self(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the provider ACL token: %w", err)
+	}
+	selfAccessor := self.AccessorID
+
+	qOpts := &api.QueryOptions{
+		Filter:  data.Filter.ValueString(),
+		PerPage: int32(data.PerPage.ValueInt64()),
+	}
+
+	matched := []string{}
+	for {
+		tflog.Debug(ctx, "Listing ACL tokens", map[string]any{"filter": qOpts.Filter, "next_token": qOpts.NextToken})
+		tokens, qm, err := client.ACLTokens().List(qOpts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing ACL tokens: %w", err)
+		}
+
+		for _, token := range tokens {
+			switch {
+			case token.AccessorID == selfAccessor:
+				continue
+			case data.Expired.ValueBool() && (token.ExpirationTime == nil || token.ExpirationTime.After(now)):
+				continue
+			case nameRegex != nil && !nameRegex.MatchString(token.Name):
+				continue
+			case !createdBefore.IsZero() && !token.CreateTime.Before(createdBefore):
+				continue
+			}
+			matched = append(matched, token.AccessorID)
+		}
+
+		if qm == nil || qm.NextToken == "" {
+			break
+		}
+		qOpts.NextToken = qm.NextToken
+	}
+
+	sort.Strings(matched)
+	return matched, nil
+}
+
+// deleteACLTokens deletes the tokens, running at most concurrency requests at
+// the same time. Tokens that no longer exist are reported as deleted. No new
+// request is started once ctx is done.
+func deleteACLTokens(ctx context.Context, client *api.Client, accessors []string, concurrency int) ([]string, []error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		deleted = []string{}
+		errs    []error
+		sem     = make(chan struct{}, concurrency)
+	)
+
+	wOpts := (&api.WriteOptions{}).WithContext(ctx)
+
+loop:
+	for _, accessor := range accessors {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("stopped deleting ACL tokens: %w", err))
+			mu.Unlock()
+			break loop
+		}
+		wg.Add(1)
+		go func(accessor string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			tflog.Debug(ctx, "Deleting ACL token", map[string]any{"accessor_id": accessor})
+			_, err := client.ACLTokens().Delete(accessor, wOpts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			// Nomad answers 400 "Cannot delete nonexistent tokens" for a
+			// token deleted since it was listed.
+			if err != nil && !strings.Contains(err.Error(), "404") && !strings.Contains(err.Error(), "nonexistent") {
+				errs = append(errs, fmt.Errorf("error deleting %q: %w", accessor, err))
+				return
+			}
+			deleted = append(deleted, accessor)
+		}(accessor)
+	}
+	wg.Wait()
+
+	sort.Strings(deleted)
+	return deleted, errs
+}
+
+// regexpValidator checks that a string is a valid regular expression.
+type regexpValidator struct{}
+
+func (v regexpValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexpValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value %q is not a valid regular expression: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// rfc3339Validator checks that a string is a time in RFC3339 format.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be a time in RFC3339 format"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time",
+			fmt.Sprintf("The value %q is not a time in RFC3339 format: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoenig/test/must"
+)
+
+func TestSelectACLTokens_selfError(t *testing.T) {
+	var listed atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/acl/token/self":
+			http.Error(w, "rpc error", http.StatusInternalServerError)
+		case "/v1/acl/tokens":
+			listed.Store(true)
+			w.Write([]byte("[]"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := api.NewClient(&api.Config{Address: srv.URL})
+	must.NoError(t, err)
+
+	data := aclTokenCleanupModel{
+		Filter:        types.StringValue(`Name != ""`),
+		NameRegex:     types.StringNull(),
+		CreatedBefore: types.StringNull(),
+		PerPage:       types.Int64Value(500),
+	}
+	_, err = selectACLTokens(t.Context(), client, data, time.Now())
+	must.ErrorContains(t, err, "error reading the provider ACL token")
+	must.False(t, listed.Load())
+}
+
+func TestDeleteACLTokens_canceled(t *testing.T) {
+	var deletes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deletes.Add(1)
+		w.Write([]byte("true"))
+	}))
+	defer srv.Close()
+
+	client, err := api.NewClient(&api.Config{Address: srv.URL})
+	must.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	deleted, errs := deleteACLTokens(ctx, client, []string{"a", "b", "c"}, 1)
+	must.SliceEmpty(t, deleted)
+	must.SliceLen(t, 1, errs)
+	must.ErrorIs(t, errs[0], context.Canceled)
+	must.Eq(t, 0, deletes.Load())
+}
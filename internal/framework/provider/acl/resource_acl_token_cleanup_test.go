@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+func TestResourceACLTokenCleanup(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf-cleanup")
+	var accessorIDs []string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				// The tokens are created outside of Terraform, as they
+				// would otherwise be recreated once deleted.
+				PreConfig: func() {
+					client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+					for i := 0; i < 2; i++ {
+						token, _, err := client.ACLTokens().Create(&api.ACLToken{
+							Name:     fmt.Sprintf("%s-%d", prefix, i),
+							Type:     "client",
+							Policies: []string{"anonymous"},
+						}, nil)
+						if err != nil {
+							t.Fatalf("error creating ACL token: %v", err)
+						}
+						accessorIDs = append(accessorIDs, token.AccessorID)
+					}
+				},
+				Config: testResourceACLTokenCleanupConfig(prefix, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_acl_token_cleanup.test", "matched_accessor_ids.#", "2"),
+					resource.TestCheckResourceAttr("nomad_acl_token_cleanup.test", "deleted_accessor_ids.#", "0"),
+					testResourceACLTokenCleanupTokensExist(t, &accessorIDs, true),
+				),
+			},
+			{
+				// Turning off dry run replaces the resource and deletes
+				// the tokens.
+				Config: testResourceACLTokenCleanupConfig(prefix, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_acl_token_cleanup.test", "matched_accessor_ids.#", "2"),
+					resource.TestCheckResourceAttr("nomad_acl_token_cleanup.test", "deleted_accessor_ids.#", "2"),
+					testResourceACLTokenCleanupTokensExist(t, &accessorIDs, false),
+				),
+			},
+			{
+				Config: `
+resource "nomad_acl_token_cleanup" "invalid" {
+  dry_run = true
+}
+`,
+				ExpectError: regexp.MustCompile("At least one of these attributes must be configured"),
+			},
+			{
+				Config: `
+resource "nomad_acl_token_cleanup" "invalid" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+		},
+	})
+}
+
+func testResourceACLTokenCleanupConfig(prefix string, dryRun bool) string {
+	return fmt.Sprintf(`
+resource "nomad_acl_token_cleanup" "test" {
+  name_regex = "^%s-"
+  dry_run    = %t
+}
+`, prefix, dryRun)
+}
+
+func testResourceACLTokenCleanupTokensExist(t *testing.T, accessorIDs *[]string, exist bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+
+		for _, accessorID := range *accessorIDs {
+			_, _, err := client.ACLTokens().Info(accessorID, nil)
+			switch {
+			case exist && err != nil:
+				return fmt.Errorf("error reading ACL token %q: %w", accessorID, err)
+			case !exist && err == nil:
+				return fmt.Errorf("ACL token %q still exists", accessorID)
+			case !exist && !strings.Contains(err.Error(), "404"):
+				return fmt.Errorf("error reading ACL token %q: %w", accessorID, err)
+			}
+		}
+		return nil
+	}
+}
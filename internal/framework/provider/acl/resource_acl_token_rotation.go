@@ -0,0 +1,434 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource                   = &ACLTokenRotationResource{}
+	_ resource.ResourceWithConfigure      = &ACLTokenRotationResource{}
+	_ resource.ResourceWithModifyPlan     = &ACLTokenRotationResource{}
+	_ resource.ResourceWithValidateConfig = &ACLTokenRotationResource{}
+)
+
+// timeNow is used to decide whether a rotation is due, it is replaced in
+// tests.
+var timeNow = time.Now
+
+type ACLTokenRotationResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewACLTokenRotationResource() resource.Resource {
+	return &ACLTokenRotationResource{}
+}
+
+type aclTokenRotationModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Policies           types.Set    `tfsdk:"policies"`
+	RoleIDs            types.Set    `tfsdk:"role_ids"`
+	Global             types.Bool   `tfsdk:"global"`
+	RotationPeriod     types.String `tfsdk:"rotation_period"`
+	Overlap            types.String `tfsdk:"overlap"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	AccessorID         types.String `tfsdk:"accessor_id"`
+	SecretID           types.String `tfsdk:"secret_id"`
+	PreviousAccessorID types.String `tfsdk:"previous_accessor_id"`
+	PreviousSecretID   types.String `tfsdk:"previous_secret_id"`
+	RotationTime       types.String `tfsdk:"rotation_time"`
+}
+
+func (r *ACLTokenRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_token_rotation"
+}
+
+func (r *ACLTokenRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	useStateForUnknown := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an ACL token that is replaced by a new token periodically. The previous token is kept for an overlap period so consumers can switch to the new token without downtime.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The accessor ID of the current token.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Human-readable name for the tokens.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("client"),
+				Description: "The type of the tokens, 'client' or 'management'. Defaults to 'client'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("client", "management"),
+				},
+			},
+			"policies": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The ACL policies to associate with the tokens, if they are 'client' tokens.",
+			},
+			"role_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the ACL roles to associate with the tokens, if they are 'client' tokens.",
+			},
+			"global": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the tokens should be replicated to all regions or not.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_period": schema.StringAttribute{
+				Optional:    true,
+				Description: "Issue a new token when the current token is older than this duration, such as \"720h\". The rotation happens during the first apply after the period elapsed.",
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+			},
+			"overlap": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("1h"),
+				Description: "How long the previous token is kept after a rotation, such as \"24h\". It is deleted during the first apply after the overlap elapsed. Defaults to \"1h\".",
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, issue a new token.",
+			},
+			"accessor_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The accessor ID of the current token.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"secret_id": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The secret of the current token.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"previous_accessor_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The accessor ID of the previous token, until it is deleted.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"previous_secret_id": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The secret of the previous token, until it is deleted.",
+				PlanModifiers: useStateForUnknown,
+			},
+			"rotation_time": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The time the current token was issued, in RFC3339 format.",
+				PlanModifiers: useStateForUnknown,
+			},
+		},
+	}
+}
+
+func (r *ACLTokenRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *ACLTokenRotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data aclTokenRotationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == "management" && (len(data.Policies.Elements()) > 0 || len(data.RoleIDs.Elements()) > 0) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid ACL token configuration",
+			"policies and role_ids can only be set for 'client' tokens.",
+		)
+	}
+}
+
+// ModifyPlan plans a rotation when the rotation period elapsed or the
+// configuration of the token changed, and plans the deletion of the previous
+// token once the overlap elapsed.
+func (r *ACLTokenRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan aclTokenRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := timeNow()
+	rotationTime, _ := time.Parse(time.RFC3339, state.RotationTime.ValueString())
+
+	if aclTokenRotationDue(state, plan, rotationTime, now) {
+		tflog.Debug(ctx, "Planning ACL token rotation", map[string]any{"accessor_id": state.AccessorID.ValueString()})
+		for _, attr := range []string{"id", "accessor_id", "secret_id", "previous_accessor_id", "previous_secret_id", "rotation_time"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.StringUnknown())...)
+		}
+		return
+	}
+
+	if !state.PreviousAccessorID.IsNull() && durationElapsed(rotationTime, plan.Overlap.ValueString(), now) {
+		tflog.Debug(ctx, "Planning deletion of the previous ACL token", map[string]any{"accessor_id": state.PreviousAccessorID.ValueString()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_accessor_id"), types.StringNull())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_secret_id"), types.StringNull())...)
+	}
+}
+
+// aclTokenRotationDue returns whether a new token must be issued, either
+// because the current token is older than the rotation period or because the
+// token configuration or the triggers changed.
+func aclTokenRotationDue(state, plan aclTokenRotationModel, rotationTime, now time.Time) bool {
+	if !plan.RotationPeriod.IsUnknown() && durationElapsed(rotationTime, plan.RotationPeriod.ValueString(), now) {
+		return true
+	}
+
+	return !state.Name.Equal(plan.Name) ||
+		!state.Type.Equal(plan.Type) ||
+		!state.Policies.Equal(plan.Policies) ||
+		!state.RoleIDs.Equal(plan.RoleIDs) ||
+		!state.Triggers.Equal(plan.Triggers)
+}
+
+// durationElapsed returns whether the duration elapsed since start. An empty
+// or unknown duration never elapses.
+func durationElapsed(start time.Time, duration string, now time.Time) bool {
+	d, err := time.ParseDuration(duration)
+	if err != nil || d <= 0 {
+		return false
+	}
+	return !now.Before(start.Add(d))
+}
+
+func (r *ACLTokenRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data aclTokenRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, diags := r.issueToken(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setCurrentToken(&data, token)
+	data.PreviousAccessorID = types.StringNull()
+	data.PreviousSecretID = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLTokenRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data aclTokenRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.providerConfig.Client()
+
+	accessorID := data.AccessorID.ValueString()
+	_, _, err := client.ACLTokens().Info(accessorID, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			// The current token is gone, a new one is issued by the next
+			// apply.
+			tflog.Debug(ctx, "ACL token not found, removing from state", map[string]any{"accessor_id": accessorID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading ACL token", fmt.Sprintf("error reading ACL token %q: %s", accessorID, err))
+		return
+	}
+
+	if !data.PreviousAccessorID.IsNull() {
+		previousID := data.PreviousAccessorID.ValueString()
+		_, _, err := client.ACLTokens().Info(previousID, nil)
+		if err != nil {
+			if !strings.Contains(err.Error(), "404") {
+				resp.Diagnostics.AddError("Error reading ACL token", fmt.Sprintf("error reading ACL token %q: %s", previousID, err))
+				return
+			}
+			tflog.Debug(ctx, "Previous ACL token not found", map[string]any{"accessor_id": previousID})
+			data.PreviousAccessorID = types.StringNull()
+			data.PreviousSecretID = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLTokenRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state aclTokenRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.AccessorID.IsUnknown():
+		token, diags := r.issueToken(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Only one previous token is kept, the one before it is deleted
+		// even if its overlap did not elapse yet.
+		if !state.PreviousAccessorID.IsNull() {
+			if err := r.deleteToken(ctx, state.PreviousAccessorID.ValueString()); err != nil {
+				resp.Diagnostics.AddWarning("Error deleting previous ACL token", err.Error())
+			}
+		}
+
+		plan.PreviousAccessorID = state.AccessorID
+		plan.PreviousSecretID = state.SecretID
+		setCurrentToken(&plan, token)
+
+	case plan.PreviousAccessorID.IsNull() && !state.PreviousAccessorID.IsNull():
+		if err := r.deleteToken(ctx, state.PreviousAccessorID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error deleting previous ACL token", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ACLTokenRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data aclTokenRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, accessorID := range []types.String{data.PreviousAccessorID, data.AccessorID} {
+		if accessorID.IsNull() {
+			continue
+		}
+		if err := r.deleteToken(ctx, accessorID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error deleting ACL token", err.Error())
+			return
+		}
+	}
+}
+
+func (r *ACLTokenRotationResource) issueToken(ctx context.Context, data aclTokenRotationModel) (*api.ACLToken, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	token := &api.ACLToken{
+		Name:   data.Name.ValueString(),
+		Type:   data.Type.ValueString(),
+		Global: data.Global.ValueBool(),
+	}
+	diags.Append(data.Policies.ElementsAs(ctx, &token.Policies, false)...)
+
+	var roleIDs []string
+	diags.Append(data.RoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, id := range roleIDs {
+		token.Roles = append(token.Roles, &api.ACLTokenRoleLink{ID: id})
+	}
+
+	tflog.Debug(ctx, "Creating ACL token", map[string]any{"name": token.Name})
+	created, _, err := r.providerConfig.Client().ACLTokens().Create(token, nil)
+	if err != nil {
+		diags.AddError("Error creating ACL token", err.Error())
+		return nil, diags
+	}
+	tflog.Debug(ctx, "Created ACL token", map[string]any{"accessor_id": created.AccessorID})
+	return created, diags
+}
+
+// deleteToken deletes a token, ignoring tokens that were already deleted.
+func (r *ACLTokenRotationResource) deleteToken(ctx context.Context, accessorID string) error {
+	tflog.Debug(ctx, "Deleting ACL token", map[string]any{"accessor_id": accessorID})
+	_, err := r.providerConfig.Client().ACLTokens().Delete(accessorID, nil)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return fmt.Errorf("error deleting ACL token %q: %w", accessorID, err)
+	}
+	return nil
+}
+
+func setCurrentToken(data *aclTokenRotationModel, token *api.ACLToken) {
+	data.ID = types.StringValue(token.AccessorID)
+	data.AccessorID = types.StringValue(token.AccessorID)
+	data.SecretID = types.StringValue(token.SecretID)
+
+	// The rotation period is measured from the time the token was created
+	// according to Nomad, falling back to the local time for older servers.
+	created := token.CreateTime
+	if created.IsZero() {
+		created = timeNow()
+	}
+	data.RotationTime = types.StringValue(created.UTC().Format(time.RFC3339))
+}
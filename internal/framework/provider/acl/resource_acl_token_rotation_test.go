@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acl_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+func TestResourceACLTokenRotation(t *testing.T) {
+	var firstAccessorID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceACLTokenRotationConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nomad_acl_token_rotation.test", "accessor_id"),
+					resource.TestCheckResourceAttrSet("nomad_acl_token_rotation.test", "secret_id"),
+					resource.TestCheckResourceAttrSet("nomad_acl_token_rotation.test", "rotation_time"),
+					resource.TestCheckNoResourceAttr("nomad_acl_token_rotation.test", "previous_accessor_id"),
+					func(s *terraform.State) error {
+						firstAccessorID = s.RootModule().Resources["nomad_acl_token_rotation.test"].Primary.Attributes["accessor_id"]
+						return nil
+					},
+				),
+			},
+			{
+				// Changing a trigger issues a new token and keeps the
+				// previous one for the overlap.
+				Config: testResourceACLTokenRotationConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("nomad_acl_token_rotation.test", "previous_accessor_id", func(v string) error {
+						if v != firstAccessorID {
+							return fmt.Errorf("expected previous_accessor_id to be %q, got %q", firstAccessorID, v)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("nomad_acl_token_rotation.test", "previous_secret_id"),
+					testResourceACLTokenRotationTokensExist(t),
+				),
+			},
+			{
+				Config: `
+resource "nomad_acl_token_rotation" "invalid" {
+  type     = "management"
+  policies = ["anonymous"]
+}
+`,
+				ExpectError: regexp.MustCompile("policies and role_ids can only be set for 'client' tokens"),
+			},
+		},
+		CheckDestroy: testResourceACLTokenRotationCheckDestroy(t),
+	})
+}
+
+func testResourceACLTokenRotationConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "nomad_acl_token_rotation" "test" {
+  name            = "terraform-rotation-test"
+  type            = "client"
+  policies        = ["anonymous"]
+  rotation_period = "720h"
+  overlap         = "24h"
+
+  triggers = {
+    version = %q
+  }
+}
+`, trigger)
+}
+
+func testResourceACLTokenRotationTokensExist(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+		attrs := s.RootModule().Resources["nomad_acl_token_rotation.test"].Primary.Attributes
+
+		for _, attr := range []string{"accessor_id", "previous_accessor_id"} {
+			if _, _, err := client.ACLTokens().Info(attrs[attr], nil); err != nil {
+				return fmt.Errorf("error reading ACL token %q: %w", attrs[attr], err)
+			}
+		}
+		return nil
+	}
+}
+
+func testResourceACLTokenRotationCheckDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "nomad_acl_token_rotation" {
+				continue
+			}
+
+			for _, attr := range []string{"accessor_id", "previous_accessor_id"} {
+				accessorID := r.Primary.Attributes[attr]
+				if accessorID == "" {
+					continue
+				}
+				_, _, err := client.ACLTokens().Info(accessorID, nil)
+				if err == nil {
+					return fmt.Errorf("ACL token %q still exists", accessorID)
+				}
+				if !strings.Contains(err.Error(), "404") {
+					return fmt.Errorf("error reading ACL token %q: %w", accessorID, err)
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &AgentMembersDataSource{}
+var _ datasource.DataSourceWithConfigure = &AgentMembersDataSource{}
+
+type AgentMembersDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewAgentMembersDataSource() datasource.DataSource {
+	return &AgentMembersDataSource{}
+}
+
+type agentMembersModel struct {
+	ID               types.String       `tfsdk:"id"`
+	ServerName       types.String       `tfsdk:"server_name"`
+	ServerRegion     types.String       `tfsdk:"server_region"`
+	ServerDatacenter types.String       `tfsdk:"server_datacenter"`
+	Members          []agentMemberModel `tfsdk:"members"`
+}
+
+type agentMemberModel struct {
+	Name       types.String      `tfsdk:"name"`
+	Address    types.String      `tfsdk:"address"`
+	Port       types.Int64       `tfsdk:"port"`
+	Status     types.String      `tfsdk:"status"`
+	Version    types.String      `tfsdk:"version"`
+	Region     types.String      `tfsdk:"region"`
+	Datacenter types.String      `tfsdk:"datacenter"`
+	Tags       map[string]string `tfsdk:"tags"`
+}
+
+func (d *AgentMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_members"
+}
+
+func (d *AgentMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the servers known to the gossip pool of the Nomad agent.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"server_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the server the request was made to.",
+			},
+			"server_region": schema.StringAttribute{
+				Computed:    true,
+				Description: "The region of the server the request was made to.",
+			},
+			"server_datacenter": schema.StringAttribute{
+				Computed:    true,
+				Description: "The datacenter of the server the request was made to.",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The servers known to the gossip pool.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the member.",
+						},
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The gossip address of the member.",
+						},
+						"port": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The gossip port of the member.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the member, such as \"alive\", \"left\" or \"failed\".",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Nomad version of the member.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "The region of the member.",
+						},
+						"datacenter": schema.StringAttribute{
+							Computed:    true,
+							Description: "The datacenter of the member.",
+						},
+						"tags": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The gossip tags of the member.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AgentMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *AgentMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data agentMembersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading agent members")
+	members, err := client.Agent().Members()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading agent members", err.Error())
+		return
+	}
+
+	data.Members = make([]agentMemberModel, 0, len(members.Members))
+	for _, member := range members.Members {
+		tags := member.Tags
+		if tags == nil {
+			tags = map[string]string{}
+		}
+		data.Members = append(data.Members, agentMemberModel{
+			Name:       types.StringValue(member.Name),
+			Address:    types.StringValue(member.Addr),
+			Port:       types.Int64Value(int64(member.Port)),
+			Status:     types.StringValue(member.Status),
+			Version:    types.StringValue(member.Tags["build"]),
+			Region:     types.StringValue(member.Tags["region"]),
+			Datacenter: types.StringValue(member.Tags["dc"]),
+			Tags:       tags,
+		})
+	}
+
+	data.ID = types.StringValue(client.Address() + "/agent-members")
+	data.ServerName = types.StringValue(members.ServerName)
+	data.ServerRegion = types.StringValue(members.ServerRegion)
+	data.ServerDatacenter = types.StringValue(members.ServerDC)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
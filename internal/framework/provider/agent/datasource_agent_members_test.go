@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package agent_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadAgentMembers_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadAgentMembersConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nomad_agent_members.test", "server_name"),
+					resource.TestCheckResourceAttr("data.nomad_agent_members.test", "server_region", "global"),
+					resource.TestCheckResourceAttr("data.nomad_agent_members.test", "members.0.status", "alive"),
+					resource.TestCheckResourceAttrSet("data.nomad_agent_members.test", "members.0.version"),
+					resource.TestCheckResourceAttrSet("data.nomad_agent_members.test", "members.0.tags.role"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNomadAgentMembersConfig = `
+data "nomad_agent_members" "test" {}
+`
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &AgentSelfDataSource{}
+var _ datasource.DataSourceWithConfigure = &AgentSelfDataSource{}
+
+type AgentSelfDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewAgentSelfDataSource() datasource.DataSource {
+	return &AgentSelfDataSource{}
+}
+
+type agentSelfModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Version       types.String `tfsdk:"version"`
+	Revision      types.String `tfsdk:"revision"`
+	Enterprise    types.Bool   `tfsdk:"enterprise"`
+	Region        types.String `tfsdk:"region"`
+	Datacenter    types.String `tfsdk:"datacenter"`
+	Server        types.Bool   `tfsdk:"server"`
+	Client        types.Bool   `tfsdk:"client"`
+	ACLEnabled    types.Bool   `tfsdk:"acl_enabled"`
+	TLSHTTP       types.Bool   `tfsdk:"tls_http"`
+	TLSRPC        types.Bool   `tfsdk:"tls_rpc"`
+	ServerVersion types.String `tfsdk:"server_version"`
+}
+
+func (d *AgentSelfDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_self"
+}
+
+func (d *AgentSelfDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the version and configuration of the Nomad agent the provider talks to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the agent.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The full version of the agent, including the prerelease and metadata.",
+			},
+			"revision": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git revision the agent was built from.",
+			},
+			"enterprise": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the agent is a Nomad Enterprise build.",
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "The region of the agent.",
+			},
+			"datacenter": schema.StringAttribute{
+				Computed:    true,
+				Description: "The datacenter of the agent.",
+			},
+			"server": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the agent runs in server mode.",
+			},
+			"client": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the agent runs in client mode.",
+			},
+			"acl_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether ACLs are enabled.",
+			},
+			"tls_http": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether TLS is enabled for the HTTP API.",
+			},
+			"tls_rpc": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether TLS is enabled for RPC and Raft traffic.",
+			},
+			"server_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The lowest version of the servers in the region, as detected by the provider. Empty if it could not be detected.",
+			},
+		},
+	}
+}
+
+func (d *AgentSelfDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *AgentSelfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data agentSelfModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading agent self")
+	self, err := client.Agent().Self()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading agent self", err.Error())
+		return
+	}
+
+	config := self.Config
+	versionInfo := configMap(config, "Version")
+	fullVersion := configString(versionInfo, "Version")
+	if prerelease := configString(versionInfo, "VersionPrerelease"); prerelease != "" {
+		fullVersion += "-" + prerelease
+	}
+	metadata := configString(versionInfo, "VersionMetadata")
+	if metadata != "" {
+		fullVersion += "+" + metadata
+	}
+
+	name := configString(config, "NodeName")
+	if name == "" {
+		name = self.Member.Name
+	}
+
+	serverVersion := ""
+	if v := d.providerConfig.ServerVersion(); v != nil {
+		serverVersion = v.String()
+	}
+
+	data.ID = types.StringValue(client.Address() + "/agent-self")
+	data.Name = types.StringValue(name)
+	data.Version = types.StringValue(fullVersion)
+	data.Revision = types.StringValue(configString(versionInfo, "Revision"))
+	data.Enterprise = types.BoolValue(metadata == "ent")
+	data.Region = types.StringValue(configString(config, "Region"))
+	data.Datacenter = types.StringValue(configString(config, "Datacenter"))
+	data.Server = types.BoolValue(configBool(configMap(config, "Server"), "Enabled"))
+	data.Client = types.BoolValue(configBool(configMap(config, "Client"), "Enabled"))
+	data.ACLEnabled = types.BoolValue(configBool(configMap(config, "ACL"), "Enabled"))
+	data.TLSHTTP = types.BoolValue(configBool(configMap(config, "TLSConfig"), "EnableHTTP"))
+	data.TLSRPC = types.BoolValue(configBool(configMap(config, "TLSConfig"), "EnableRPC"))
+	data.ServerVersion = types.StringValue(serverVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// The agent configuration is returned as untyped JSON, these helpers return
+// the zero value when a key is missing or has an unexpected type.
+
+func configMap(config map[string]any, key string) map[string]any {
+	m, _ := config[key].(map[string]any)
+	return m
+}
+
+func configString(config map[string]any, key string) string {
+	s, _ := config[key].(string)
+	return s
+}
+
+func configBool(config map[string]any, key string) bool {
+	b, _ := config[key].(bool)
+	return b
+}
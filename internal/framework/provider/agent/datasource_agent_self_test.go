@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package agent_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadAgentSelf_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadAgentSelfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nomad_agent_self.test", "name"),
+					resource.TestCheckResourceAttrSet("data.nomad_agent_self.test", "version"),
+					resource.TestCheckResourceAttr("data.nomad_agent_self.test", "region", "global"),
+					resource.TestCheckResourceAttr("data.nomad_agent_self.test", "datacenter", "dc1"),
+					resource.TestCheckResourceAttr("data.nomad_agent_self.test", "server", "true"),
+					resource.TestCheckResourceAttrSet("data.nomad_agent_self.test", "server_version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNomadAgentSelfConfig = `
+data "nomad_agent_self" "test" {}
+`
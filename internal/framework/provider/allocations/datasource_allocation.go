@@ -0,0 +1,647 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package allocations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+// defaultTaskEventsLimit is the number of most recent events returned for each
+// task when task_events_limit is not set.
+const defaultTaskEventsLimit = 10
+
+var _ datasource.DataSource = &AllocationDataSource{}
+var _ datasource.DataSourceWithConfigure = &AllocationDataSource{}
+
+type AllocationDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewAllocationDataSource() datasource.DataSource {
+	return &AllocationDataSource{}
+}
+
+type allocationModel struct {
+	ID                 types.String                `tfsdk:"id"`
+	Namespace          types.String                `tfsdk:"namespace"`
+	TaskEventsLimit    types.Int64                 `tfsdk:"task_events_limit"`
+	IncludeStats       types.Bool                  `tfsdk:"include_stats"`
+	EvalID             types.String                `tfsdk:"eval_id"`
+	Name               types.String                `tfsdk:"name"`
+	NodeID             types.String                `tfsdk:"node_id"`
+	NodeName           types.String                `tfsdk:"node_name"`
+	JobID              types.String                `tfsdk:"job_id"`
+	JobVersion         types.Int64                 `tfsdk:"job_version"`
+	TaskGroup          types.String                `tfsdk:"task_group"`
+	DesiredStatus      types.String                `tfsdk:"desired_status"`
+	DesiredDescription types.String                `tfsdk:"desired_description"`
+	ClientStatus       types.String                `tfsdk:"client_status"`
+	ClientDescription  types.String                `tfsdk:"client_description"`
+	DeploymentID       types.String                `tfsdk:"deployment_id"`
+	DeploymentStatus   *allocDeploymentStatusModel `tfsdk:"deployment_status"`
+	FollowupEvalID     types.String                `tfsdk:"followup_eval_id"`
+	PreviousAllocation types.String                `tfsdk:"previous_allocation"`
+	NextAllocation     types.String                `tfsdk:"next_allocation"`
+	TaskStates         map[string]taskStateModel   `tfsdk:"task_states"`
+	Ports              []allocPortModel            `tfsdk:"ports"`
+	NetworkStatus      *allocNetworkStatusModel    `tfsdk:"network_status"`
+	ResourceUsage      *allocResourceUsageModel    `tfsdk:"resource_usage"`
+	CreateIndex        types.Int64                 `tfsdk:"create_index"`
+	ModifyIndex        types.Int64                 `tfsdk:"modify_index"`
+	CreateTime         types.Int64                 `tfsdk:"create_time"`
+	ModifyTime         types.Int64                 `tfsdk:"modify_time"`
+}
+
+type allocDeploymentStatusModel struct {
+	Healthy     types.Bool   `tfsdk:"healthy"`
+	Timestamp   types.String `tfsdk:"timestamp"`
+	Canary      types.Bool   `tfsdk:"canary"`
+	ModifyIndex types.Int64  `tfsdk:"modify_index"`
+}
+
+type taskStateModel struct {
+	State       types.String     `tfsdk:"state"`
+	Failed      types.Bool       `tfsdk:"failed"`
+	Restarts    types.Int64      `tfsdk:"restarts"`
+	LastRestart types.String     `tfsdk:"last_restart"`
+	StartedAt   types.String     `tfsdk:"started_at"`
+	FinishedAt  types.String     `tfsdk:"finished_at"`
+	Events      []taskEventModel `tfsdk:"events"`
+}
+
+type taskEventModel struct {
+	Type           types.String            `tfsdk:"type"`
+	Time           types.String            `tfsdk:"time"`
+	DisplayMessage types.String            `tfsdk:"display_message"`
+	Details        map[string]types.String `tfsdk:"details"`
+}
+
+type allocPortModel struct {
+	Label  types.String `tfsdk:"label"`
+	Value  types.Int64  `tfsdk:"value"`
+	To     types.Int64  `tfsdk:"to"`
+	HostIP types.String `tfsdk:"host_ip"`
+}
+
+type allocNetworkStatusModel struct {
+	InterfaceName types.String `tfsdk:"interface_name"`
+	Address       types.String `tfsdk:"address"`
+	AddressIPv6   types.String `tfsdk:"address_ipv6"`
+}
+
+type allocResourceUsageModel struct {
+	Timestamp types.String                  `tfsdk:"timestamp"`
+	Usage     *resourceUsageModel           `tfsdk:"usage"`
+	Tasks     map[string]resourceUsageModel `tfsdk:"tasks"`
+}
+
+type resourceUsageModel struct {
+	CPUPercent     types.Float64 `tfsdk:"cpu_percent"`
+	CPUTotalTicks  types.Float64 `tfsdk:"cpu_total_ticks"`
+	MemoryRSS      types.Int64   `tfsdk:"memory_rss"`
+	MemoryUsage    types.Int64   `tfsdk:"memory_usage"`
+	MemoryMaxUsage types.Int64   `tfsdk:"memory_max_usage"`
+}
+
+func resourceUsageAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cpu_percent": schema.Float64Attribute{
+			Computed:    true,
+			Description: "The CPU usage as a percentage of the allocated CPU.",
+		},
+		"cpu_total_ticks": schema.Float64Attribute{
+			Computed:    true,
+			Description: "The CPU usage in MHz.",
+		},
+		"memory_rss": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The resident set size in bytes.",
+		},
+		"memory_usage": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The memory usage in bytes.",
+		},
+		"memory_max_usage": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The maximum memory usage in bytes.",
+		},
+	}
+}
+
+func (d *AllocationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allocation"
+}
+
+func (d *AllocationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve information about a single Nomad allocation, including task states, allocated ports and deployment health.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the allocation.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The namespace of the allocation.",
+			},
+			"task_events_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of most recent events to return for each task. Defaults to 10.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"include_stats": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to query the client running the allocation for its live resource usage.",
+			},
+			"eval_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the evaluation that created the allocation.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the allocation.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the node the allocation is placed on.",
+			},
+			"node_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the node the allocation is placed on.",
+			},
+			"job_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the job the allocation belongs to.",
+			},
+			"job_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version of the job the allocation was created from.",
+			},
+			"task_group": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the task group the allocation belongs to.",
+			},
+			"desired_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the allocation desired by the servers.",
+			},
+			"desired_description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The reason for the desired status.",
+			},
+			"client_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the allocation reported by the client.",
+			},
+			"client_description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The reason for the client status.",
+			},
+			"deployment_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the deployment the allocation is part of.",
+			},
+			"deployment_status": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The health of the allocation within its deployment.",
+				Attributes: map[string]schema.Attribute{
+					"healthy": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the allocation is healthy. Unset while the health is still being determined.",
+					},
+					"timestamp": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the health was set.",
+					},
+					"canary": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the allocation is a canary.",
+					},
+					"modify_index": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The Raft index at which the deployment status was last modified.",
+					},
+				},
+			},
+			"followup_eval_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the evaluation created to reschedule the allocation.",
+			},
+			"previous_allocation": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the allocation this allocation replaced.",
+			},
+			"next_allocation": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the allocation that replaced this allocation.",
+			},
+			"task_states": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The state of each task in the allocation, keyed by task name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the task: pending, running or dead.",
+						},
+						"failed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the task has failed.",
+						},
+						"restarts": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of times the task has restarted.",
+						},
+						"last_restart": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time of the last restart.",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the task was last started.",
+						},
+						"finished_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the task finished.",
+						},
+						"events": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The most recent events of the task, oldest first.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "The type of the event.",
+									},
+									"time": schema.StringAttribute{
+										Computed:    true,
+										Description: "The time of the event.",
+									},
+									"display_message": schema.StringAttribute{
+										Computed:    true,
+										Description: "A human-readable description of the event.",
+									},
+									"details": schema.MapAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+										Description: "Additional details of the event.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ports": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The ports allocated to the allocation.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "The label of the port.",
+						},
+						"value": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The port number on the host.",
+						},
+						"to": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The port number inside the allocation network namespace.",
+						},
+						"host_ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address of the host the port is bound to.",
+						},
+					},
+				},
+			},
+			"network_status": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The status of the allocation network, for allocations using bridge or CNI networking.",
+				Attributes: map[string]schema.Attribute{
+					"interface_name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the network interface.",
+					},
+					"address": schema.StringAttribute{
+						Computed:    true,
+						Description: "The IPv4 address of the allocation.",
+					},
+					"address_ipv6": schema.StringAttribute{
+						Computed:    true,
+						Description: "The IPv6 address of the allocation.",
+					},
+				},
+			},
+			"resource_usage": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The live resource usage of the allocation. Only set when include_stats is true.",
+				Attributes: map[string]schema.Attribute{
+					"timestamp": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the statistics were collected.",
+					},
+					"usage": schema.SingleNestedAttribute{
+						Computed:    true,
+						Description: "The aggregated resource usage of all tasks.",
+						Attributes:  resourceUsageAttributes(),
+					},
+					"tasks": schema.MapNestedAttribute{
+						Computed:    true,
+						Description: "The resource usage of each task, keyed by task name.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: resourceUsageAttributes(),
+						},
+					},
+				},
+			},
+			"create_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index at which the allocation was created.",
+			},
+			"modify_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index at which the allocation was last modified.",
+			},
+			"create_time": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The time the allocation was created, in nanoseconds since the Unix epoch.",
+			},
+			"modify_time": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The time the allocation was last modified, in nanoseconds since the Unix epoch.",
+			},
+		},
+	}
+}
+
+func (d *AllocationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *AllocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data allocationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	id := data.ID.ValueString()
+	qOpts := &api.QueryOptions{Namespace: data.Namespace.ValueString()}
+
+	tflog.Debug(ctx, "Reading allocation", map[string]any{"id": id})
+	alloc, _, err := client.Allocations().Info(id, qOpts)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.Diagnostics.AddError("Allocation not found", fmt.Sprintf("No allocation found with ID %q.", id))
+			return
+		}
+		resp.Diagnostics.AddError("Error reading allocation", fmt.Sprintf("error reading %q: %s", id, err))
+		return
+	}
+
+	eventsLimit := defaultTaskEventsLimit
+	if !data.TaskEventsLimit.IsNull() {
+		eventsLimit = int(data.TaskEventsLimit.ValueInt64())
+	}
+
+	data.Namespace = types.StringValue(alloc.Namespace)
+	data.EvalID = types.StringValue(alloc.EvalID)
+	data.Name = types.StringValue(alloc.Name)
+	data.NodeID = types.StringValue(alloc.NodeID)
+	data.NodeName = types.StringValue(alloc.NodeName)
+	data.JobID = types.StringValue(alloc.JobID)
+	data.JobVersion = types.Int64Null()
+	if alloc.Job != nil && alloc.Job.Version != nil {
+		data.JobVersion = types.Int64Value(int64(*alloc.Job.Version))
+	}
+	data.TaskGroup = types.StringValue(alloc.TaskGroup)
+	data.DesiredStatus = types.StringValue(alloc.DesiredStatus)
+	data.DesiredDescription = types.StringValue(alloc.DesiredDescription)
+	data.ClientStatus = types.StringValue(alloc.ClientStatus)
+	data.ClientDescription = types.StringValue(alloc.ClientDescription)
+	data.DeploymentID = types.StringValue(alloc.DeploymentID)
+	data.DeploymentStatus = flattenAllocDeploymentStatus(alloc.DeploymentStatus)
+	data.FollowupEvalID = types.StringValue(alloc.FollowupEvalID)
+	data.PreviousAllocation = types.StringValue(alloc.PreviousAllocation)
+	data.NextAllocation = types.StringValue(alloc.NextAllocation)
+	data.Ports = flattenAllocPorts(alloc.AllocatedResources)
+	data.NetworkStatus = flattenAllocNetworkStatus(alloc.NetworkStatus)
+	data.CreateIndex = types.Int64Value(int64(alloc.CreateIndex))
+	data.ModifyIndex = types.Int64Value(int64(alloc.ModifyIndex))
+	data.CreateTime = types.Int64Value(alloc.CreateTime)
+	data.ModifyTime = types.Int64Value(alloc.ModifyTime)
+
+	data.TaskStates = make(map[string]taskStateModel, len(alloc.TaskStates))
+	for name, state := range alloc.TaskStates {
+		data.TaskStates[name] = flattenTaskState(state, eventsLimit)
+	}
+
+	data.ResourceUsage = nil
+	if data.IncludeStats.ValueBool() {
+		tflog.Debug(ctx, "Reading allocation stats", map[string]any{"id": id})
+		stats, err := client.Allocations().Stats(alloc, qOpts)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading allocation stats", fmt.Sprintf("error reading stats for %q: %s", id, err))
+			return
+		}
+		data.ResourceUsage = flattenAllocResourceUsage(stats)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenAllocDeploymentStatus(status *api.AllocDeploymentStatus) *allocDeploymentStatusModel {
+	if status == nil {
+		return nil
+	}
+
+	healthy := types.BoolNull()
+	if status.Healthy != nil {
+		healthy = types.BoolValue(*status.Healthy)
+	}
+
+	return &allocDeploymentStatusModel{
+		Healthy:     healthy,
+		Timestamp:   helper.FormatTime(status.Timestamp),
+		Canary:      types.BoolValue(status.Canary),
+		ModifyIndex: types.Int64Value(int64(status.ModifyIndex)),
+	}
+}
+
+// flattenAllocPorts returns the ports of the group network followed by the
+// ports of any task-level networks, which older jobs may still use.
+func flattenAllocPorts(resources *api.AllocatedResources) []allocPortModel {
+	ports := []allocPortModel{}
+	if resources == nil {
+		return ports
+	}
+
+	for _, port := range resources.Shared.Ports {
+		ports = append(ports, allocPortModel{
+			Label:  types.StringValue(port.Label),
+			Value:  types.Int64Value(int64(port.Value)),
+			To:     types.Int64Value(int64(port.To)),
+			HostIP: types.StringValue(port.HostIP),
+		})
+	}
+
+	for _, task := range resources.Tasks {
+		if task == nil {
+			continue
+		}
+		for _, network := range task.Networks {
+			for _, portList := range [][]api.Port{network.ReservedPorts, network.DynamicPorts} {
+				for _, port := range portList {
+					ports = append(ports, allocPortModel{
+						Label:  types.StringValue(port.Label),
+						Value:  types.Int64Value(int64(port.Value)),
+						To:     types.Int64Value(int64(port.To)),
+						HostIP: types.StringValue(network.IP),
+					})
+				}
+			}
+		}
+	}
+
+	return ports
+}
+
+func flattenAllocNetworkStatus(status *api.AllocNetworkStatus) *allocNetworkStatusModel {
+	if status == nil {
+		return nil
+	}
+
+	return &allocNetworkStatusModel{
+		InterfaceName: types.StringValue(status.InterfaceName),
+		Address:       types.StringValue(status.Address),
+		AddressIPv6:   types.StringValue(status.AddressIPv6),
+	}
+}
+
+func flattenTaskState(state *api.TaskState, eventsLimit int) taskStateModel {
+	if state == nil {
+		return taskStateModel{Events: []taskEventModel{}}
+	}
+
+	events := state.Events
+	if len(events) > eventsLimit {
+		events = events[len(events)-eventsLimit:]
+	}
+
+	eventModels := make([]taskEventModel, 0, len(events))
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		details := make(map[string]types.String, len(event.Details))
+		for k, v := range event.Details {
+			details[k] = types.StringValue(v)
+		}
+
+		eventModels = append(eventModels, taskEventModel{
+			Type:           types.StringValue(event.Type),
+			Time:           helper.FormatTime(time.Unix(0, event.Time)),
+			DisplayMessage: types.StringValue(event.DisplayMessage),
+			Details:        details,
+		})
+	}
+
+	return taskStateModel{
+		State:       types.StringValue(state.State),
+		Failed:      types.BoolValue(state.Failed),
+		Restarts:    types.Int64Value(int64(state.Restarts)),
+		LastRestart: helper.FormatTime(state.LastRestart),
+		StartedAt:   helper.FormatTime(state.StartedAt),
+		FinishedAt:  helper.FormatTime(state.FinishedAt),
+		Events:      eventModels,
+	}
+}
+
+func flattenAllocResourceUsage(stats *api.AllocResourceUsage) *allocResourceUsageModel {
+	if stats == nil {
+		return nil
+	}
+
+	tasks := make(map[string]resourceUsageModel, len(stats.Tasks))
+	for name, task := range stats.Tasks {
+		if task == nil {
+			continue
+		}
+		tasks[name] = flattenResourceUsage(task.ResourceUsage)
+	}
+
+	usage := flattenResourceUsage(stats.ResourceUsage)
+	return &allocResourceUsageModel{
+		Timestamp: helper.FormatTime(time.Unix(0, stats.Timestamp)),
+		Usage:     &usage,
+		Tasks:     tasks,
+	}
+}
+
+func flattenResourceUsage(usage *api.ResourceUsage) resourceUsageModel {
+	model := resourceUsageModel{
+		CPUPercent:     types.Float64Null(),
+		CPUTotalTicks:  types.Float64Null(),
+		MemoryRSS:      types.Int64Null(),
+		MemoryUsage:    types.Int64Null(),
+		MemoryMaxUsage: types.Int64Null(),
+	}
+	if usage == nil {
+		return model
+	}
+
+	if usage.CpuStats != nil {
+		model.CPUPercent = types.Float64Value(usage.CpuStats.Percent)
+		model.CPUTotalTicks = types.Float64Value(usage.CpuStats.TotalTicks)
+	}
+	if usage.MemoryStats != nil {
+		model.MemoryRSS = types.Int64Value(int64(usage.MemoryStats.RSS))
+		model.MemoryUsage = types.Int64Value(int64(usage.MemoryStats.Usage))
+		model.MemoryMaxUsage = types.Int64Value(int64(usage.MemoryStats.MaxUsage))
+	}
+
+	return model
+}
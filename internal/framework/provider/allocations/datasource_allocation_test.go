@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package allocations_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadAllocation_basic(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-alloc-ds-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// The allocation ID is only known once the job is running, so
+				// it is passed to the configuration as a Terraform variable.
+				PreConfig: func() { t.Setenv("TF_VAR_alloc_id", registerTestJob(t, jobID)) },
+				Config:    testAccDataSourceNomadAllocationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_allocation.test", "job_id", jobID),
+					resource.TestCheckResourceAttr("data.nomad_allocation.test", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.nomad_allocation.test", "task_group", "web"),
+					resource.TestCheckResourceAttr("data.nomad_allocation.test", "client_status", "running"),
+					resource.TestCheckResourceAttr("data.nomad_allocation.test", "task_states.server.state", "running"),
+					resource.TestCheckResourceAttrSet("data.nomad_allocation.test", "task_states.server.events.#"),
+					resource.TestCheckResourceAttrSet("data.nomad_allocation.test", "resource_usage.usage.memory_rss"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadAllocationConfig() string {
+	return `
+variable "alloc_id" {
+  type = string
+}
+
+data "nomad_allocation" "test" {
+  id                = var.alloc_id
+  task_events_limit = 5
+  include_stats     = true
+}
+`
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package allocations
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+const (
+	logOriginStart = "start"
+	logOriginEnd   = "end"
+)
+
+var _ ephemeral.EphemeralResource = &AllocationFileEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AllocationFileEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &AllocationFileEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &AllocationFileEphemeralResource{}
+
+type AllocationFileEphemeralResource struct {
+	SDKv2Meta func() any
+}
+
+type allocationFileEphemeralModel struct {
+	AllocationID  types.String `tfsdk:"allocation_id"`
+	Namespace     types.String `tfsdk:"namespace"`
+	Path          types.String `tfsdk:"path"`
+	Task          types.String `tfsdk:"task"`
+	LogType       types.String `tfsdk:"log_type"`
+	Origin        types.String `tfsdk:"origin"`
+	Offset        types.Int64  `tfsdk:"offset"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+}
+
+func NewAllocationFileEphemeralResource() ephemeral.EphemeralResource {
+	return &AllocationFileEphemeralResource{}
+}
+
+func (r *AllocationFileEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allocation_file"
+}
+
+func (r *AllocationFileEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeralschema.Schema{
+		Description: "Reads a file from an allocation directory, or the logs of one of its tasks, without storing the content in state.",
+		Attributes: map[string]ephemeralschema.Attribute{
+			"allocation_id": ephemeralschema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the allocation.",
+			},
+			"namespace": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The namespace of the allocation.",
+			},
+			"path": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The path of the file to read, relative to the root of the allocation directory. Conflicts with log_type.",
+			},
+			"task": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: "The task whose logs are read. Required with log_type.",
+			},
+			"log_type": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: `The log stream to read, either "stdout" or "stderr". Conflicts with path.`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.FSLogNameStdout, api.FSLogNameStderr),
+				},
+			},
+			"origin": ephemeralschema.StringAttribute{
+				Optional:    true,
+				Description: `Whether offset is applied from the "start" or the "end" of the logs. Defaults to "start". Only valid with log_type.`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(logOriginStart, logOriginEnd),
+				},
+			},
+			"offset": ephemeralschema.Int64Attribute{
+				Optional:    true,
+				Description: "The byte offset to start reading from.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"limit": ephemeralschema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of bytes to read. If not set, the content is read to the end.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"content": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The content that was read.",
+			},
+			"content_base64": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The content that was read, base64-encoded. Useful for binary files.",
+			},
+		},
+	}
+}
+
+func (r *AllocationFileEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("path"),
+			path.MatchRoot("log_type"),
+		),
+		ephemeralvalidator.RequiredTogether(
+			path.MatchRoot("task"),
+			path.MatchRoot("log_type"),
+		),
+	}
+}
+
+func (r *AllocationFileEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config allocationFileEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Origin.IsNull() && config.LogType.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("origin"), "Invalid attribute combination",
+			"origin can only be set when reading logs with log_type.")
+	}
+}
+
+func (r *AllocationFileEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdkv2Meta, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected provider data of type func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.SDKv2Meta = sdkv2Meta
+}
+
+func (r *AllocationFileEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config allocationFileEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		log.Printf("[DEBUG] nomad_allocation_file: config decoding returned diagnostics")
+		return
+	}
+
+	if r.SDKv2Meta == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Nomad Provider",
+			"The provider has not been configured. Configure the nomad provider before using nomad_allocation_file.",
+		)
+		return
+	}
+
+	providerData := r.SDKv2Meta()
+	providerConfig, ok := providerData.(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Metadata Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", providerData),
+		)
+		return
+	}
+
+	client := providerConfig.Client()
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Nomad Client",
+			"The provider did not expose a configured Nomad API client.",
+		)
+		return
+	}
+
+	allocID := config.AllocationID.ValueString()
+	qOpts := &api.QueryOptions{Namespace: config.Namespace.ValueString()}
+
+	log.Printf("[DEBUG] nomad_allocation_file: reading allocation %q", allocID)
+	alloc, _, err := client.Allocations().Info(allocID, qOpts)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.Diagnostics.AddError("Allocation not found", fmt.Sprintf("No allocation found with ID %q.", allocID))
+			return
+		}
+
+		resp.Diagnostics.AddError("Error reading allocation", err.Error())
+		return
+	}
+
+	offset := config.Offset.ValueInt64()
+	limit := config.Limit.ValueInt64()
+
+	var content []byte
+	if !config.LogType.IsNull() {
+		origin := logOriginStart
+		if !config.Origin.IsNull() {
+			origin = config.Origin.ValueString()
+		}
+
+		log.Printf("[DEBUG] nomad_allocation_file: reading %s logs of task %q in allocation %q", config.LogType.ValueString(), config.Task.ValueString(), allocID)
+		content, err = readAllocLogs(ctx, client, alloc, config.Task.ValueString(), config.LogType.ValueString(), origin, offset, limit, qOpts)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading allocation logs", err.Error())
+			return
+		}
+	} else {
+		log.Printf("[DEBUG] nomad_allocation_file: reading file %q in allocation %q", config.Path.ValueString(), allocID)
+		content, err = readAllocFile(client, alloc, config.Path.ValueString(), offset, limit, qOpts)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading allocation file", err.Error())
+			return
+		}
+	}
+	log.Printf("[DEBUG] nomad_allocation_file: successfully read %d bytes from allocation %q", len(content), allocID)
+
+	config.Namespace = types.StringValue(alloc.Namespace)
+	config.Content = types.StringValue(string(content))
+	config.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// readAllocFile reads the file at path in the allocation directory. The whole
+// file is read with Cat unless an offset or limit is set.
+func readAllocFile(client *api.Client, alloc *api.Allocation, path string, offset, limit int64, qOpts *api.QueryOptions) ([]byte, error) {
+	var (
+		r   io.ReadCloser
+		err error
+	)
+	if offset == 0 && limit == 0 {
+		r, err = client.AllocFS().Cat(alloc, path, qOpts)
+	} else {
+		r, err = client.AllocFS().ReadAt(alloc, path, offset, limit, qOpts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// readAllocLogs reads the logs of a task until the end of the stream or until
+// limit bytes have been read.
+func readAllocLogs(ctx context.Context, client *api.Client, alloc *api.Allocation, task, logType, origin string, offset, limit int64, qOpts *api.QueryOptions) ([]byte, error) {
+	cancel := make(chan struct{})
+	defer close(cancel)
+
+	frames, errCh := client.AllocFS().Logs(alloc, false, task, logType, origin, offset, cancel, qOpts)
+
+	var buf bytes.Buffer
+	for {
+		select {
+		case frame, ok := <-frames:
+			if !ok {
+				return buf.Bytes(), nil
+			}
+			buf.Write(frame.Data)
+			if limit > 0 && int64(buf.Len()) >= limit {
+				return buf.Bytes()[:limit], nil
+			}
+		case err := <-errCh:
+			return nil, err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package allocations_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccEphemeralAllocationFile_logs(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-alloc-file-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { t.Setenv("TF_VAR_alloc_id", registerTestJob(t, jobID)) },
+				Config:    testAccEphemeralAllocationFileLogsConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("content"),
+						knownvalue.StringExact("started"),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("content_base64"),
+						knownvalue.StringExact("c3RhcnRlZA=="),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEphemeralAllocationFileLogsConfig() string {
+	return `
+provider "nomad" {}
+
+variable "alloc_id" {
+  type = string
+}
+
+ephemeral "nomad_allocation_file" "test" {
+  allocation_id = var.alloc_id
+  task          = "server"
+  log_type      = "stdout"
+  limit         = 7
+}
+
+provider "echo" {
+  data = ephemeral.nomad_allocation_file.test
+}
+
+resource "echo" "test" {}
+`
+}
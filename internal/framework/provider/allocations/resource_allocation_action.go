@@ -0,0 +1,426 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package allocations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+const (
+	allocationActionRestart = "restart"
+	allocationActionStop    = "stop"
+	allocationActionSignal  = "signal"
+)
+
+var (
+	_ resource.Resource                     = &AllocationActionResource{}
+	_ resource.ResourceWithConfigure        = &AllocationActionResource{}
+	_ resource.ResourceWithConfigValidators = &AllocationActionResource{}
+	_ resource.ResourceWithValidateConfig   = &AllocationActionResource{}
+)
+
+type AllocationActionResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewAllocationActionResource() resource.Resource {
+	return &AllocationActionResource{}
+}
+
+type allocationActionModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Action                types.String `tfsdk:"action"`
+	Namespace             types.String `tfsdk:"namespace"`
+	AllocationIDs         types.Set    `tfsdk:"allocation_ids"`
+	Filter                types.String `tfsdk:"filter"`
+	IncludeTerminal       types.Bool   `tfsdk:"include_terminal"`
+	Task                  types.String `tfsdk:"task"`
+	AllTasks              types.Bool   `tfsdk:"all_tasks"`
+	Signal                types.String `tfsdk:"signal"`
+	Triggers              types.Map    `tfsdk:"triggers"`
+	SelectedAllocationIDs types.List   `tfsdk:"selected_allocation_ids"`
+	EvalIDs               types.Map    `tfsdk:"eval_ids"`
+}
+
+func (r *AllocationActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allocation_action"
+}
+
+func (r *AllocationActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts, stops or signals Nomad allocations. The action runs when the resource is created and again whenever any of its arguments or triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: `The action to run against the selected allocations. Valid values are "restart", "stop" and "signal".`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(allocationActionRestart, allocationActionStop, allocationActionSignal),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "The namespace used to look up allocations. Use \"*\" to select allocations across all namespaces.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocation_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the allocations to act on. Conflicts with filter.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "An expression used to select the allocations to act on, using the same syntax as the nomad_allocations data source. Conflicts with allocation_ids.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_terminal": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Also select the allocations matching filter that are complete, failed or lost. By default they are skipped, since they cannot be restarted or signaled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"task": schema.StringAttribute{
+				Optional:    true,
+				Description: "The task to restart or signal. If not set, every running task in the allocation is targeted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"all_tasks": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Restart all tasks in the allocation, including tasks that are not running, following their lifecycle order. Only valid with the restart action.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"signal": schema.StringAttribute{
+				Optional:    true,
+				Description: `The signal to send, such as "SIGHUP". Required with the signal action.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, will run the action again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"selected_allocation_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the allocations the action was run against successfully.",
+			},
+			"eval_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The follow-up evaluation ID created for each stopped allocation, keyed by allocation ID.",
+			},
+		},
+	}
+}
+
+func (r *AllocationActionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("allocation_ids"),
+			path.MatchRoot("filter"),
+		),
+	}
+}
+
+func (r *AllocationActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data allocationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Action.IsUnknown() {
+		return
+	}
+
+	action := data.Action.ValueString()
+	if action == allocationActionSignal && data.Signal.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("signal"), "Missing signal",
+			fmt.Sprintf("signal must be set when action is %q.", allocationActionSignal))
+	}
+	if action != allocationActionSignal && !data.Signal.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("signal"), "Invalid attribute combination",
+			fmt.Sprintf("signal can only be set when action is %q.", allocationActionSignal))
+	}
+	if action != allocationActionRestart && data.AllTasks.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("all_tasks"), "Invalid attribute combination",
+			fmt.Sprintf("all_tasks can only be set when action is %q.", allocationActionRestart))
+	}
+	if !data.IncludeTerminal.IsNull() && data.Filter.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("include_terminal"), "Invalid attribute combination",
+			"include_terminal can only be set with filter.")
+	}
+	if action == allocationActionStop && !data.Task.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("task"), "Invalid attribute combination",
+			fmt.Sprintf("task cannot be set when action is %q, allocations are always stopped as a whole.", allocationActionStop))
+	}
+	if data.AllTasks.ValueBool() && !data.Task.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("all_tasks"), "Invalid attribute combination",
+			"all_tasks and task cannot be set at the same time.")
+	}
+}
+
+func (r *AllocationActionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *AllocationActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data allocationActionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.providerConfig.Client()
+
+	var allocIDs []string
+	if !data.AllocationIDs.IsNull() {
+		resp.Diagnostics.Append(data.AllocationIDs.ElementsAs(ctx, &allocIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	allocs, diags := selectAllocations(ctx, client, data.Namespace.ValueString(), allocIDs, data.Filter.ValueString(), data.IncludeTerminal.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(allocs) == 0 {
+		resp.Diagnostics.AddWarning(
+			"No allocations selected",
+			fmt.Sprintf("No allocations matched the filter %q, the %s action was not run. Complete, failed and lost allocations are only selected when include_terminal is set.", data.Filter.ValueString(), data.Action.ValueString()),
+		)
+	}
+
+	// The action is run against every allocation even if some of them fail,
+	// the ones that succeeded are recorded so a failed run does not hide
+	// what was already done.
+	selected := make([]string, 0, len(allocs))
+	evalIDs := make(map[string]string)
+	var failed []string
+	for _, alloc := range allocs {
+		evalID, err := runAllocationAction(ctx, client, alloc, data)
+		if err != nil {
+			failed = append(failed, alloc.ID)
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error running %s action", data.Action.ValueString()),
+				fmt.Sprintf("error running %s on allocation %q: %s", data.Action.ValueString(), alloc.ID, err),
+			)
+			continue
+		}
+		selected = append(selected, alloc.ID)
+		if evalID != "" {
+			evalIDs[alloc.ID] = evalID
+		}
+	}
+	if len(failed) > 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Partial %s action", data.Action.ValueString()),
+			fmt.Sprintf("The %s action failed on %d of %d allocations (%s). It succeeded on: %s.",
+				data.Action.ValueString(), len(failed), len(allocs), strings.Join(failed, ", "), joinOrNone(selected)),
+		)
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	selectedList, diags := types.ListValueFrom(ctx, types.StringType, selected)
+	resp.Diagnostics.Append(diags...)
+	data.SelectedAllocationIDs = selectedList
+
+	evalIDsMap, diags := types.MapValueFrom(ctx, types.StringType, evalIDs)
+	resp.Diagnostics.Append(diags...)
+	data.EvalIDs = evalIDsMap
+
+	// The state is saved even when the action failed on some allocations,
+	// Terraform then marks the resource as tainted so the next apply runs
+	// the action again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op: the resource records an action that already happened, so
+// there is nothing in Nomad to refresh it against.
+func (r *AllocationActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data allocationActionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is only reached when nothing that requires replacement changed, so
+// the planned values are stored as they are.
+func (r *AllocationActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data allocationActionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, allocations are left as they
+// are.
+func (r *AllocationActionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// selectAllocations returns the allocations identified either by their IDs or
+// by a filter expression. Allocations selected by the filter that are in a
+// terminal client status are skipped unless includeTerminal is set.
+func selectAllocations(ctx context.Context, client *api.Client, namespace string, ids []string, filter string, includeTerminal bool) ([]*api.Allocation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(ids) == 0 {
+		tflog.Debug(ctx, "Listing allocations", map[string]any{"namespace": namespace, "filter": filter})
+		stubs, _, err := client.Allocations().List(&api.QueryOptions{
+			Namespace: namespace,
+			Filter:    filter,
+		})
+		if err != nil {
+			diags.AddError("Error listing allocations", err.Error())
+			return nil, diags
+		}
+		for _, stub := range stubs {
+			if !includeTerminal && isTerminalClientStatus(stub.ClientStatus) {
+				tflog.Debug(ctx, "Skipping terminal allocation", map[string]any{"alloc_id": stub.ID, "client_status": stub.ClientStatus})
+				continue
+			}
+			ids = append(ids, stub.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	allocs := make([]*api.Allocation, 0, len(ids))
+	for _, id := range ids {
+		alloc, _, err := client.Allocations().Info(id, &api.QueryOptions{Namespace: namespace})
+		if err != nil {
+			diags.AddError("Error reading allocation", fmt.Sprintf("error reading allocation %q: %s", id, err))
+			return nil, diags
+		}
+		allocs = append(allocs, alloc)
+	}
+
+	return allocs, diags
+}
+
+// isTerminalClientStatus returns whether an allocation with this client status
+// has stopped running for good.
+func isTerminalClientStatus(status string) bool {
+	switch status {
+	case api.AllocClientStatusComplete, api.AllocClientStatusFailed, api.AllocClientStatusLost:
+		return true
+	}
+	return false
+}
+
+func joinOrNone(ids []string) string {
+	if len(ids) == 0 {
+		return "none"
+	}
+	return strings.Join(ids, ", ")
+}
+
+// runAllocationAction runs the configured action against a single
+// allocation. It returns the follow-up evaluation ID when the allocation was
+// stopped.
+func runAllocationAction(ctx context.Context, client *api.Client, alloc *api.Allocation, data allocationActionModel) (string, error) {
+	qOpts := &api.QueryOptions{Namespace: alloc.Namespace}
+	task := data.Task.ValueString()
+	logFields := map[string]any{"alloc_id": alloc.ID, "task": task}
+
+	switch data.Action.ValueString() {
+	case allocationActionRestart:
+		tflog.Debug(ctx, "Restarting allocation", logFields)
+		if data.AllTasks.ValueBool() {
+			return "", client.Allocations().RestartAllTasks(alloc, qOpts)
+		}
+		return "", client.Allocations().Restart(alloc, task, qOpts)
+
+	case allocationActionSignal:
+		tflog.Debug(ctx, "Signaling allocation", logFields)
+		return "", client.Allocations().Signal(alloc, qOpts, task, data.Signal.ValueString())
+
+	case allocationActionStop:
+		tflog.Debug(ctx, "Stopping allocation", logFields)
+		stopResp, err := client.Allocations().Stop(alloc, qOpts)
+		if err != nil {
+			return "", err
+		}
+		return stopResp.EvalID, nil
+	}
+
+	return "", fmt.Errorf("unsupported action %q", data.Action.ValueString())
+}
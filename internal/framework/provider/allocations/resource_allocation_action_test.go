@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package allocations_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+	"github.com/shoenig/test/must"
+)
+
+func TestResourceAllocationAction_restart(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-alloc-action-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { registerTestJob(t, jobID) },
+				Config:    testResourceAllocationActionConfig(jobID, "restart", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_allocation_action.test", "selected_allocation_ids.#", "1"),
+					resource.TestCheckResourceAttr("nomad_allocation_action.test", "eval_ids.%", "0"),
+				),
+			},
+			{
+				Config: testResourceAllocationActionConfig(jobID, "restart", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_allocation_action.test", "selected_allocation_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAllocationAction_signalRequiresSignal(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nomad_allocation_action" "test" {
+  action = "signal"
+  filter = "JobID == \"example\""
+}
+`,
+				ExpectError: regexp.MustCompile("signal must be set"),
+			},
+		},
+	})
+}
+
+func testResourceAllocationActionConfig(jobID, action, trigger string) string {
+	return fmt.Sprintf(`
+resource "nomad_allocation_action" "test" {
+  action = %q
+  filter = "JobID == \"%s\" and ClientStatus == \"running\""
+
+  triggers = {
+    version = %q
+  }
+}
+`, action, jobID, trigger)
+}
+
+// registerTestJob registers a service job with a single allocation and waits
+// for that allocation to be running.
+func registerTestJob(t *testing.T, jobID string) string {
+	t.Helper()
+
+	providerData := testutil.SDKV2ProviderMeta(t)()
+	providerConfig, ok := providerData.(nomad.ProviderConfig)
+	must.True(t, ok, must.Sprintf("expected nomad.ProviderConfig, got %T", providerData))
+
+	client := providerConfig.Client()
+
+	job := &api.Job{
+		ID:          pointerOf(jobID),
+		Name:        pointerOf(jobID),
+		Type:        pointerOf("service"),
+		Datacenters: []string{"dc1"},
+		TaskGroups: []*api.TaskGroup{
+			{
+				Name:  pointerOf("web"),
+				Count: pointerOf(1),
+				Tasks: []*api.Task{
+					{
+						Name:   "server",
+						Driver: "docker",
+						Config: map[string]interface{}{
+							"image":   "busybox:1",
+							"command": "sh",
+							"args":    []string{"-c", "echo started; sleep 3600"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, _, err := client.Jobs().Register(job, nil)
+	must.NoError(t, err, must.Sprintf("failed to register test job"))
+
+	t.Cleanup(func() {
+		client.Jobs().Deregister(jobID, true, nil)
+	})
+
+	deadline := time.Now().Add(60 * time.Second)
+	for time.Now().Before(deadline) {
+		allocs, _, err := client.Jobs().Allocations(jobID, false, nil)
+		if err == nil {
+			for _, alloc := range allocs {
+				if alloc.ClientStatus == api.AllocClientStatusRunning {
+					return alloc.ID
+				}
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	t.Fatalf("allocation for job %q not running within timeout", jobID)
+	return ""
+}
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
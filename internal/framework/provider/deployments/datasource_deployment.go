@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &DeploymentDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DeploymentDataSource{}
+
+type DeploymentDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+type deploymentModel struct {
+	ID                 types.String                    `tfsdk:"id"`
+	Namespace          types.String                    `tfsdk:"namespace"`
+	JobID              types.String                    `tfsdk:"job_id"`
+	JobVersion         types.Int64                     `tfsdk:"job_version"`
+	JobModifyIndex     types.Int64                     `tfsdk:"job_modify_index"`
+	JobSpecModifyIndex types.Int64                     `tfsdk:"job_spec_modify_index"`
+	JobCreateIndex     types.Int64                     `tfsdk:"job_create_index"`
+	IsMultiregion      types.Bool                      `tfsdk:"is_multiregion"`
+	Status             types.String                    `tfsdk:"status"`
+	StatusDescription  types.String                    `tfsdk:"status_description"`
+	TaskGroups         map[string]deploymentStateModel `tfsdk:"task_groups"`
+	CreateIndex        types.Int64                     `tfsdk:"create_index"`
+	ModifyIndex        types.Int64                     `tfsdk:"modify_index"`
+	CreateTime         types.Int64                     `tfsdk:"create_time"`
+	ModifyTime         types.Int64                     `tfsdk:"modify_time"`
+}
+
+type deploymentStateModel struct {
+	AutoRevert        types.Bool   `tfsdk:"auto_revert"`
+	Promoted          types.Bool   `tfsdk:"promoted"`
+	ProgressDeadline  types.String `tfsdk:"progress_deadline"`
+	RequireProgressBy types.String `tfsdk:"require_progress_by"`
+	PlacedCanaries    []string     `tfsdk:"placed_canaries"`
+	DesiredCanaries   types.Int64  `tfsdk:"desired_canaries"`
+	DesiredTotal      types.Int64  `tfsdk:"desired_total"`
+	PlacedAllocs      types.Int64  `tfsdk:"placed_allocs"`
+	HealthyAllocs     types.Int64  `tfsdk:"healthy_allocs"`
+	UnhealthyAllocs   types.Int64  `tfsdk:"unhealthy_allocs"`
+}
+
+// deploymentAttributes returns the computed attributes of a deployment shared
+// by nomad_deployment and the deployment_list of nomad_deployments.
+func deploymentAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the deployment.",
+		},
+		"namespace": schema.StringAttribute{
+			Computed:    true,
+			Description: "The namespace of the deployment.",
+		},
+		"job_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the job the deployment is for.",
+		},
+		"job_version": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The version of the job the deployment is tracking.",
+		},
+		"job_modify_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The modify index of the job the deployment is tracking.",
+		},
+		"job_spec_modify_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The job modify index of the job the deployment is tracking.",
+		},
+		"job_create_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The create index of the job the deployment is tracking.",
+		},
+		"is_multiregion": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the deployment is part of a multi-region deployment.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the deployment.",
+		},
+		"status_description": schema.StringAttribute{
+			Computed:    true,
+			Description: "The reason for the status of the deployment.",
+		},
+		"task_groups": schema.MapNestedAttribute{
+			Computed:    true,
+			Description: "The state of the deployment of each task group, keyed by task group name.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"auto_revert": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the job is reverted to its last stable version if the deployment fails.",
+					},
+					"promoted": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the canaries of the task group have been promoted.",
+					},
+					"progress_deadline": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time an allocation has to become healthy before the deployment fails.",
+					},
+					"require_progress_by": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time by which an allocation must become healthy before the deployment fails.",
+					},
+					"placed_canaries": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "The IDs of the canary allocations placed.",
+					},
+					"desired_canaries": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of canaries desired.",
+					},
+					"desired_total": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of allocations desired.",
+					},
+					"placed_allocs": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of allocations placed.",
+					},
+					"healthy_allocs": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of allocations that are healthy.",
+					},
+					"unhealthy_allocs": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of allocations that are unhealthy.",
+					},
+				},
+			},
+		},
+		"create_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The Raft index at which the deployment was created.",
+		},
+		"modify_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The Raft index at which the deployment was last modified.",
+		},
+		"create_time": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The time the deployment was created, in nanoseconds since the Unix epoch.",
+		},
+		"modify_time": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The time the deployment was last modified, in nanoseconds since the Unix epoch.",
+		},
+	}
+}
+
+func (d *DeploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := deploymentAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the deployment. Conflicts with job_id.",
+	}
+	attrs["namespace"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The namespace of the deployment.",
+	}
+	attrs["job_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the job whose latest deployment is read. Conflicts with id.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieve information about a single Nomad deployment.",
+		Attributes:  attrs,
+	}
+}
+
+func (d *DeploymentDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("job_id"),
+		),
+	}
+}
+
+func (d *DeploymentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data deploymentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+	qOpts := &api.QueryOptions{Namespace: data.Namespace.ValueString()}
+
+	var (
+		deployment *api.Deployment
+		err        error
+	)
+	if !data.JobID.IsNull() {
+		jobID := data.JobID.ValueString()
+
+		tflog.Debug(ctx, "Reading latest deployment of job", map[string]any{"job_id": jobID})
+		deployment, _, err = client.Jobs().LatestDeployment(jobID, qOpts)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				resp.Diagnostics.AddError("Job not found", fmt.Sprintf("No job found with ID %q.", jobID))
+				return
+			}
+
+			resp.Diagnostics.AddError("Error reading latest deployment", err.Error())
+			return
+		}
+		if deployment == nil {
+			resp.Diagnostics.AddError("Deployment not found", fmt.Sprintf("Job %q has no deployment.", jobID))
+			return
+		}
+	} else {
+		id := data.ID.ValueString()
+
+		tflog.Debug(ctx, "Reading deployment", map[string]any{"id": id})
+		deployment, _, err = client.Deployments().Info(id, qOpts)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				resp.Diagnostics.AddError("Deployment not found", fmt.Sprintf("No deployment found with ID %q.", id))
+				return
+			}
+
+			resp.Diagnostics.AddError("Error reading deployment", err.Error())
+			return
+		}
+	}
+
+	data = flattenDeployment(deployment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenDeployment(deployment *api.Deployment) deploymentModel {
+	taskGroups := make(map[string]deploymentStateModel, len(deployment.TaskGroups))
+	for name, state := range deployment.TaskGroups {
+		if state == nil {
+			continue
+		}
+
+		placedCanaries := make([]string, len(state.PlacedCanaries))
+		copy(placedCanaries, state.PlacedCanaries)
+		sort.Strings(placedCanaries)
+
+		taskGroups[name] = deploymentStateModel{
+			AutoRevert:        types.BoolValue(state.AutoRevert),
+			Promoted:          types.BoolValue(state.Promoted),
+			ProgressDeadline:  types.StringValue(state.ProgressDeadline.String()),
+			RequireProgressBy: helper.FormatTime(state.RequireProgressBy),
+			PlacedCanaries:    placedCanaries,
+			DesiredCanaries:   types.Int64Value(int64(state.DesiredCanaries)),
+			DesiredTotal:      types.Int64Value(int64(state.DesiredTotal)),
+			PlacedAllocs:      types.Int64Value(int64(state.PlacedAllocs)),
+			HealthyAllocs:     types.Int64Value(int64(state.HealthyAllocs)),
+			UnhealthyAllocs:   types.Int64Value(int64(state.UnhealthyAllocs)),
+		}
+	}
+
+	return deploymentModel{
+		ID:                 types.StringValue(deployment.ID),
+		Namespace:          types.StringValue(deployment.Namespace),
+		JobID:              types.StringValue(deployment.JobID),
+		JobVersion:         types.Int64Value(int64(deployment.JobVersion)),
+		JobModifyIndex:     types.Int64Value(int64(deployment.JobModifyIndex)),
+		JobSpecModifyIndex: types.Int64Value(int64(deployment.JobSpecModifyIndex)),
+		JobCreateIndex:     types.Int64Value(int64(deployment.JobCreateIndex)),
+		IsMultiregion:      types.BoolValue(deployment.IsMultiregion),
+		Status:             types.StringValue(deployment.Status),
+		StatusDescription:  types.StringValue(deployment.StatusDescription),
+		TaskGroups:         taskGroups,
+		CreateIndex:        types.Int64Value(int64(deployment.CreateIndex)),
+		ModifyIndex:        types.Int64Value(int64(deployment.ModifyIndex)),
+		CreateTime:         types.Int64Value(deployment.CreateTime),
+		ModifyTime:         types.Int64Value(deployment.ModifyTime),
+	}
+}
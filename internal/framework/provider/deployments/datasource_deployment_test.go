@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deployments_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadDeployment_basic(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-deployment-ds-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// The deployment ID is only known once the job is registered,
+				// so it is passed to the configuration as a Terraform variable.
+				PreConfig: func() { t.Setenv("TF_VAR_deployment_id", registerDeploymentJob(t, jobID)) },
+				Config:    testAccDataSourceNomadDeploymentConfig(jobID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_deployment.by_id", "job_id", jobID),
+					resource.TestCheckResourceAttr("data.nomad_deployment.by_id", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.nomad_deployment.by_id", "job_version", "0"),
+					resource.TestCheckResourceAttr("data.nomad_deployment.by_id", "task_groups.foo.desired_total", "1"),
+					resource.TestCheckResourceAttr("data.nomad_deployment.by_id", "task_groups.foo.promoted", "false"),
+					resource.TestCheckResourceAttrPair("data.nomad_deployment.by_job", "id", "data.nomad_deployment.by_id", "id"),
+				),
+			},
+			{
+				Config:      testAccDataSourceNomadDeploymentConfigMissing(),
+				ExpectError: regexp.MustCompile("Deployment not found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadDeploymentConfig(jobID string) string {
+	return fmt.Sprintf(`
+variable "deployment_id" {
+  type = string
+}
+
+data "nomad_deployment" "by_id" {
+  id = var.deployment_id
+}
+
+data "nomad_deployment" "by_job" {
+  job_id = %q
+}
+`, jobID)
+}
+
+func testAccDataSourceNomadDeploymentConfigMissing() string {
+	return `
+data "nomad_deployment" "test" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+`
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentsDataSource{}
+
+type DeploymentsDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type deploymentsModel struct {
+	ID             types.String        `tfsdk:"id"`
+	Namespace      types.String        `tfsdk:"namespace"`
+	Prefix         types.String        `tfsdk:"prefix"`
+	JobID          types.String        `tfsdk:"job_id"`
+	Status         types.String        `tfsdk:"status"`
+	Filter         types.String        `tfsdk:"filter"`
+	PerPage        types.Int64         `tfsdk:"per_page"`
+	NextToken      types.String        `tfsdk:"next_token"`
+	NextPageToken  types.String        `tfsdk:"next_page_token"`
+	DeploymentList []deploymentModel   `tfsdk:"deployment_list"`
+	Deployments    []map[string]string `tfsdk:"deployments"`
+}
+
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve a list of Nomad deployments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "The namespace to list deployments from. Use \"*\" for all namespaces.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments whose ID starts with this prefix.",
+			},
+			"job_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments for this job.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments with this status, such as \"running\" or \"failed\".",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "An expression used to filter the deployments.",
+			},
+			"per_page": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of deployments to return. Only one page is read, use next_page_token to read the next one. If not set, Nomad returns all the deployments in a single page.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"next_token": schema.StringAttribute{
+				Optional:    true,
+				Description: "The token of the page to start listing from, as returned in next_page_token.",
+			},
+			"next_page_token": schema.StringAttribute{
+				Computed:    true,
+				Description: "The token to use as next_token to read the next page. Empty when there are no more pages.",
+			},
+			"deployment_list": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The deployments found.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deploymentAttributes(),
+				},
+			},
+			"deployments": schema.ListAttribute{
+				Computed:           true,
+				ElementType:        types.MapType{ElemType: types.StringType},
+				Description:        "The deployments found, as maps with the keys ID, JobID, JobVersion, Status and StatusDescription.",
+				DeprecationMessage: "Use deployment_list instead. The deployments attribute will be removed in a future release.",
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data deploymentsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	qOpts := &api.QueryOptions{
+		Namespace: data.Namespace.ValueString(),
+		Prefix:    data.Prefix.ValueString(),
+		Filter:    deploymentsFilter(data),
+		PerPage:   int32(data.PerPage.ValueInt64()),
+		NextToken: data.NextToken.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Listing deployments", map[string]any{
+		"namespace": qOpts.Namespace,
+		"filter":    qOpts.Filter,
+	})
+	deployments, meta, err := client.Deployments().List(qOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing deployments", err.Error())
+		return
+	}
+
+	data.DeploymentList = make([]deploymentModel, 0, len(deployments))
+	data.Deployments = make([]map[string]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		data.DeploymentList = append(data.DeploymentList, flattenDeployment(deployment))
+		data.Deployments = append(data.Deployments, map[string]string{
+			"ID":                deployment.ID,
+			"JobID":             deployment.JobID,
+			"JobVersion":        strconv.FormatUint(deployment.JobVersion, 10),
+			"Status":            deployment.Status,
+			"StatusDescription": deployment.StatusDescription,
+		})
+	}
+
+	data.ID = types.StringValue(client.Address() + "/deployments")
+	data.NextPageToken = types.StringValue("")
+	if meta != nil {
+		data.NextPageToken = types.StringValue(meta.NextToken)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// deploymentsFilter combines the job_id and status arguments with the filter
+// expression, since the deployments list endpoint has no dedicated parameters
+// for them.
+func deploymentsFilter(data deploymentsModel) string {
+	var exprs []string
+	if !data.JobID.IsNull() {
+		exprs = append(exprs, "JobID == "+strconv.Quote(data.JobID.ValueString()))
+	}
+	if !data.Status.IsNull() {
+		exprs = append(exprs, "Status == "+strconv.Quote(data.Status.ValueString()))
+	}
+	if f := data.Filter.ValueString(); f != "" {
+		if len(exprs) == 0 {
+			return f
+		}
+		exprs = append(exprs, "("+f+")")
+	}
+	return strings.Join(exprs, " and ")
+}
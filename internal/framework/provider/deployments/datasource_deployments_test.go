@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deployments_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+	"github.com/shoenig/test/must"
+)
+
+func TestAccDataSourceNomadDeployments_basic(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-deployments-ds-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { registerDeploymentJob(t, jobID) },
+				Config:    testAccDataSourceNomadDeploymentsConfig(jobID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_deployments.test", "deployment_list.#", "1"),
+					resource.TestCheckResourceAttr("data.nomad_deployments.test", "deployment_list.0.job_id", jobID),
+					resource.TestCheckResourceAttr("data.nomad_deployments.test", "deployment_list.0.namespace", "default"),
+					resource.TestCheckResourceAttr("data.nomad_deployments.test", "deployment_list.0.task_groups.foo.desired_total", "1"),
+					resource.TestCheckResourceAttrSet("data.nomad_deployments.test", "deployment_list.0.task_groups.foo.require_progress_by"),
+					resource.TestCheckResourceAttr("data.nomad_deployments.test", "deployments.#", "1"),
+					resource.TestCheckResourceAttr("data.nomad_deployments.test", "deployments.0.JobID", jobID),
+					resource.TestCheckResourceAttr("data.nomad_deployments.none", "deployment_list.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadDeploymentsConfig(jobID string) string {
+	return fmt.Sprintf(`
+data "nomad_deployments" "test" {
+  namespace = "*"
+  job_id    = %[1]q
+}
+
+data "nomad_deployments" "none" {
+  job_id = %[1]q
+  filter = "JobVersion > 100"
+}
+`, jobID)
+}
+
+// registerDeploymentJob registers a service job with an update block, so a
+// deployment is created, and returns the ID of that deployment.
+func registerDeploymentJob(t *testing.T, jobID string) string {
+	t.Helper()
+
+	providerData := testutil.SDKV2ProviderMeta(t)()
+	providerConfig, ok := providerData.(nomad.ProviderConfig)
+	must.True(t, ok, must.Sprintf("expected nomad.ProviderConfig, got %T", providerData))
+
+	client := providerConfig.Client()
+
+	job := &api.Job{
+		ID:          pointerOf(jobID),
+		Name:        pointerOf(jobID),
+		Type:        pointerOf("service"),
+		Datacenters: []string{"dc1"},
+		Update:      &api.UpdateStrategy{},
+		TaskGroups: []*api.TaskGroup{
+			{
+				Name:  pointerOf("foo"),
+				Count: pointerOf(1),
+				Tasks: []*api.Task{
+					{
+						Name:   "foo",
+						Driver: "raw_exec",
+						Config: map[string]interface{}{
+							"command": "/bin/sleep",
+							"args":    []string{"3600"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, _, err := client.Jobs().Register(job, nil)
+	must.NoError(t, err, must.Sprintf("failed to register test job"))
+
+	t.Cleanup(func() {
+		client.Jobs().Deregister(jobID, true, nil)
+	})
+
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		deployment, _, err := client.Jobs().LatestDeployment(jobID, nil)
+		if err == nil && deployment != nil {
+			return deployment.ID
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	t.Fatalf("deployment for job %q not created within timeout", jobID)
+	return ""
+}
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
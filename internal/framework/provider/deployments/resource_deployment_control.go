@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource                   = &DeploymentControlResource{}
+	_ resource.ResourceWithConfigure      = &DeploymentControlResource{}
+	_ resource.ResourceWithValidateConfig = &DeploymentControlResource{}
+)
+
+type DeploymentControlResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewDeploymentControlResource() resource.Resource {
+	return &DeploymentControlResource{}
+}
+
+type deploymentControlModel struct {
+	ID                     types.String `tfsdk:"id"`
+	DeploymentID           types.String `tfsdk:"deployment_id"`
+	Namespace              types.String `tfsdk:"namespace"`
+	Paused                 types.Bool   `tfsdk:"paused"`
+	PauseTrigger           types.String `tfsdk:"pause_trigger"`
+	FailTrigger            types.String `tfsdk:"fail_trigger"`
+	HealthyAllocationIDs   types.Set    `tfsdk:"healthy_allocation_ids"`
+	UnhealthyAllocationIDs types.Set    `tfsdk:"unhealthy_allocation_ids"`
+	AllocHealthTrigger     types.String `tfsdk:"alloc_health_trigger"`
+	EvalID                 types.String `tfsdk:"eval_id"`
+}
+
+func (r *DeploymentControlResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_control"
+}
+
+func (r *DeploymentControlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pauses, resumes or fails a Nomad deployment and sets the health of its allocations. Each action runs when the resource is created and again whenever its arguments or trigger change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the deployment to control.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "The namespace of the deployment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the deployment is paused or resumed. The deployment is paused or resumed whenever this value or pause_trigger changes.",
+			},
+			"pause_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, pauses or resumes the deployment again according to paused.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("paused")),
+				},
+			},
+			"fail_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when set or changed, fails the deployment.",
+			},
+			"healthy_allocation_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the allocations to mark as healthy.",
+			},
+			"unhealthy_allocation_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the allocations to mark as unhealthy.",
+			},
+			"alloc_health_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, sets the health of the allocations again.",
+			},
+			"eval_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the evaluation created by the last action run.",
+			},
+		},
+	}
+}
+
+func (r *DeploymentControlResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data deploymentControlModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AllocHealthTrigger.IsNull() && data.HealthyAllocationIDs.IsNull() && data.UnhealthyAllocationIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("alloc_health_trigger"), "Missing allocations",
+			"alloc_health_trigger requires healthy_allocation_ids or unhealthy_allocation_ids to be set.")
+	}
+}
+
+func (r *DeploymentControlResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *DeploymentControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data deploymentControlModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.DeploymentID
+	resp.Diagnostics.Append(r.runActions(ctx, nil, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op: the resource records actions that already happened, so
+// there is nothing in Nomad to refresh it against.
+func (r *DeploymentControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data deploymentControlModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update runs the actions whose arguments or trigger changed.
+func (r *DeploymentControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state deploymentControlModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.EvalID = state.EvalID
+	resp.Diagnostics.Append(r.runActions(ctx, &state, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state, the deployment is left as it
+// is.
+func (r *DeploymentControlResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// runActions runs the actions configured in plan. When state is not nil only
+// the actions whose arguments or trigger differ from state are run. The
+// allocation health is set first, as pausing or failing the deployment does
+// not prevent it, and failing the deployment is done last.
+func (r *DeploymentControlResource) runActions(ctx context.Context, state, plan *deploymentControlModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := r.providerConfig.Client()
+	deploymentID := plan.DeploymentID.ValueString()
+	wOpts := &api.WriteOptions{Namespace: plan.Namespace.ValueString()}
+	logFields := map[string]any{"deployment_id": deploymentID}
+
+	setAllocHealth := !plan.HealthyAllocationIDs.IsNull() || !plan.UnhealthyAllocationIDs.IsNull()
+	if state != nil {
+		setAllocHealth = setAllocHealth && (!plan.HealthyAllocationIDs.Equal(state.HealthyAllocationIDs) ||
+			!plan.UnhealthyAllocationIDs.Equal(state.UnhealthyAllocationIDs) ||
+			!plan.AllocHealthTrigger.Equal(state.AllocHealthTrigger))
+	}
+	if setAllocHealth {
+		var healthy, unhealthy []string
+		diags.Append(plan.HealthyAllocationIDs.ElementsAs(ctx, &healthy, false)...)
+		diags.Append(plan.UnhealthyAllocationIDs.ElementsAs(ctx, &unhealthy, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		tflog.Debug(ctx, "Setting deployment allocation health", logFields)
+		updateResp, _, err := client.Deployments().SetAllocHealth(deploymentID, healthy, unhealthy, wOpts)
+		if err != nil {
+			diags.AddError("Error setting allocation health", fmt.Sprintf("error setting allocation health in deployment %q: %s", deploymentID, err))
+			return diags
+		}
+		plan.EvalID = types.StringValue(updateResp.EvalID)
+	}
+
+	pause := !plan.Paused.IsNull()
+	if state != nil {
+		pause = pause && (!plan.Paused.Equal(state.Paused) || !plan.PauseTrigger.Equal(state.PauseTrigger))
+	}
+	if pause {
+		tflog.Debug(ctx, "Pausing deployment", map[string]any{"deployment_id": deploymentID, "pause": plan.Paused.ValueBool()})
+		updateResp, _, err := client.Deployments().Pause(deploymentID, plan.Paused.ValueBool(), wOpts)
+		if err != nil {
+			diags.AddError("Error pausing deployment", fmt.Sprintf("error setting paused to %t on deployment %q: %s", plan.Paused.ValueBool(), deploymentID, err))
+			return diags
+		}
+		plan.EvalID = types.StringValue(updateResp.EvalID)
+	}
+
+	fail := !plan.FailTrigger.IsNull()
+	if state != nil {
+		fail = fail && !plan.FailTrigger.Equal(state.FailTrigger)
+	}
+	if fail {
+		tflog.Debug(ctx, "Failing deployment", logFields)
+		updateResp, _, err := client.Deployments().Fail(deploymentID, wOpts)
+		if err != nil {
+			diags.AddError("Error failing deployment", fmt.Sprintf("error failing deployment %q: %s", deploymentID, err))
+			return diags
+		}
+		plan.EvalID = types.StringValue(updateResp.EvalID)
+	}
+
+	if plan.EvalID.IsUnknown() {
+		plan.EvalID = types.StringValue("")
+	}
+
+	return diags
+}
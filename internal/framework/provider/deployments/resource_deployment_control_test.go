@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deployments_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+	"github.com/shoenig/test/must"
+)
+
+func TestAccResourceNomadDeploymentControl_basic(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-deployment-control-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { t.Setenv("TF_VAR_deployment_id", registerManualHealthDeploymentJob(t, jobID)) },
+				Config:    testAccResourceNomadDeploymentControlConfig(`paused = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nomad_deployment_control.test", "eval_id"),
+					testAccCheckDeploymentStatus(t, "paused"),
+				),
+			},
+			{
+				Config: testAccResourceNomadDeploymentControlConfig(`paused = false`),
+				Check:  testAccCheckDeploymentStatus(t, "running"),
+			},
+			{
+				Config: testAccResourceNomadDeploymentControlConfig(`
+  paused       = false
+  fail_trigger = "1"
+`),
+				Check: testAccCheckDeploymentStatus(t, "failed"),
+			},
+		},
+	})
+}
+
+func testAccResourceNomadDeploymentControlConfig(body string) string {
+	return fmt.Sprintf(`
+variable "deployment_id" {
+  type = string
+}
+
+resource "nomad_deployment_control" "test" {
+  deployment_id = var.deployment_id
+  %s
+}
+`, body)
+}
+
+func testAccCheckDeploymentStatus(t *testing.T, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["nomad_deployment_control.test"]
+		if !ok {
+			return fmt.Errorf("resource nomad_deployment_control.test not found in state")
+		}
+
+		client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+		deployment, _, err := client.Deployments().Info(rs.Primary.Attributes["deployment_id"], nil)
+		if err != nil {
+			return fmt.Errorf("error reading deployment: %w", err)
+		}
+		if deployment.Status != status {
+			return fmt.Errorf("expected deployment status %q, got %q", status, deployment.Status)
+		}
+		return nil
+	}
+}
+
+// registerManualHealthDeploymentJob registers a service job whose allocation
+// health is set manually, so its deployment stays running until the test sets
+// it, and returns the ID of that deployment.
+func registerManualHealthDeploymentJob(t *testing.T, jobID string) string {
+	t.Helper()
+
+	providerData := testutil.SDKV2ProviderMeta(t)()
+	providerConfig, ok := providerData.(nomad.ProviderConfig)
+	must.True(t, ok, must.Sprintf("expected nomad.ProviderConfig, got %T", providerData))
+
+	client := providerConfig.Client()
+
+	job := &api.Job{
+		ID:          pointerOf(jobID),
+		Name:        pointerOf(jobID),
+		Type:        pointerOf("service"),
+		Datacenters: []string{"dc1"},
+		Update: &api.UpdateStrategy{
+			HealthCheck: pointerOf("manual"),
+		},
+		TaskGroups: []*api.TaskGroup{
+			{
+				Name:  pointerOf("foo"),
+				Count: pointerOf(1),
+				Tasks: []*api.Task{
+					{
+						Name:   "foo",
+						Driver: "raw_exec",
+						Config: map[string]interface{}{
+							"command": "/bin/sleep",
+							"args":    []string{"3600"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, _, err := client.Jobs().Register(job, nil)
+	must.NoError(t, err, must.Sprintf("failed to register test job"))
+
+	t.Cleanup(func() {
+		client.Jobs().Deregister(jobID, true, nil)
+	})
+
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		deployment, _, err := client.Jobs().LatestDeployment(jobID, nil)
+		if err == nil && deployment != nil {
+			return deployment.ID
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	t.Fatalf("deployment for job %q not created within timeout", jobID)
+	return ""
+}
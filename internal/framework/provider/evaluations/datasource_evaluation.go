@@ -0,0 +1,396 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evaluations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &EvaluationDataSource{}
+var _ datasource.DataSourceWithConfigure = &EvaluationDataSource{}
+
+type EvaluationDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewEvaluationDataSource() datasource.DataSource {
+	return &EvaluationDataSource{}
+}
+
+type evaluationModel struct {
+	ID                   types.String                     `tfsdk:"id"`
+	Namespace            types.String                     `tfsdk:"namespace"`
+	Priority             types.Int64                      `tfsdk:"priority"`
+	Type                 types.String                     `tfsdk:"type"`
+	TriggeredBy          types.String                     `tfsdk:"triggered_by"`
+	JobID                types.String                     `tfsdk:"job_id"`
+	JobModifyIndex       types.Int64                      `tfsdk:"job_modify_index"`
+	NodeID               types.String                     `tfsdk:"node_id"`
+	DeploymentID         types.String                     `tfsdk:"deployment_id"`
+	Status               types.String                     `tfsdk:"status"`
+	StatusDescription    types.String                     `tfsdk:"status_description"`
+	WaitUntil            types.String                     `tfsdk:"wait_until"`
+	NextEval             types.String                     `tfsdk:"next_eval"`
+	PreviousEval         types.String                     `tfsdk:"previous_eval"`
+	BlockedEval          types.String                     `tfsdk:"blocked_eval"`
+	RelatedEvals         []evaluationStubModel            `tfsdk:"related_evals"`
+	FailedTGAllocs       map[string]allocationMetricModel `tfsdk:"failed_tg_allocs"`
+	ClassEligibility     map[string]bool                  `tfsdk:"class_eligibility"`
+	EscapedComputedClass types.Bool                       `tfsdk:"escaped_computed_class"`
+	QuotaLimitReached    types.String                     `tfsdk:"quota_limit_reached"`
+	QueuedAllocations    map[string]int64                 `tfsdk:"queued_allocations"`
+	SnapshotIndex        types.Int64                      `tfsdk:"snapshot_index"`
+	CreateIndex          types.Int64                      `tfsdk:"create_index"`
+	ModifyIndex          types.Int64                      `tfsdk:"modify_index"`
+	CreateTime           types.Int64                      `tfsdk:"create_time"`
+	ModifyTime           types.Int64                      `tfsdk:"modify_time"`
+}
+
+type allocationMetricModel struct {
+	NodePool           types.String     `tfsdk:"node_pool"`
+	NodesInPool        types.Int64      `tfsdk:"nodes_in_pool"`
+	NodesEvaluated     types.Int64      `tfsdk:"nodes_evaluated"`
+	NodesFiltered      types.Int64      `tfsdk:"nodes_filtered"`
+	NodesExhausted     types.Int64      `tfsdk:"nodes_exhausted"`
+	NodesAvailable     map[string]int64 `tfsdk:"nodes_available"`
+	ClassFiltered      map[string]int64 `tfsdk:"class_filtered"`
+	ConstraintFiltered map[string]int64 `tfsdk:"constraint_filtered"`
+	ClassExhausted     map[string]int64 `tfsdk:"class_exhausted"`
+	DimensionExhausted map[string]int64 `tfsdk:"dimension_exhausted"`
+	QuotaExhausted     []string         `tfsdk:"quota_exhausted"`
+	CoalescedFailures  types.Int64      `tfsdk:"coalesced_failures"`
+}
+
+func (d *EvaluationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation"
+}
+
+func (d *EvaluationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	countMap := func(description string) schema.MapAttribute {
+		return schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieve information about a single Nomad evaluation, including the reasons its allocations could not be placed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the evaluation.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The namespace of the evaluation.",
+			},
+			"priority": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The priority of the evaluation.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the job that triggered the evaluation.",
+			},
+			"triggered_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The event that triggered the evaluation.",
+			},
+			"job_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the job the evaluation is for.",
+			},
+			"job_modify_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The modify index of the job at the time the evaluation was created.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the node that triggered the evaluation, if any.",
+			},
+			"deployment_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the deployment that triggered the evaluation, if any.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the evaluation.",
+			},
+			"status_description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The reason for the status of the evaluation.",
+			},
+			"wait_until": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time until which the evaluation is delayed.",
+			},
+			"next_eval": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the evaluation that follows this one.",
+			},
+			"previous_eval": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the evaluation that preceded this one.",
+			},
+			"blocked_eval": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the blocked evaluation created because of failed placements.",
+			},
+			"related_evals": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The evaluations related to this one, such as the evaluations it created or was created by.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: evaluationStubAttributes(),
+				},
+			},
+			"failed_tg_allocs": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The placement metrics of the task groups that could not be fully placed, keyed by task group name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_pool": schema.StringAttribute{
+							Computed:    true,
+							Description: "The node pool the placement was attempted in.",
+						},
+						"nodes_in_pool": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of nodes in the node pool.",
+						},
+						"nodes_evaluated": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of nodes that were evaluated.",
+						},
+						"nodes_filtered": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of nodes that were filtered out.",
+						},
+						"nodes_exhausted": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of nodes that did not have enough resources.",
+						},
+						"nodes_available": countMap("The number of available nodes, keyed by datacenter."),
+						"class_filtered":  countMap("The number of nodes filtered out, keyed by node class."),
+						"constraint_filtered": countMap(
+							"The number of nodes filtered out, keyed by constraint.",
+						),
+						"class_exhausted": countMap("The number of nodes exhausted, keyed by node class."),
+						"dimension_exhausted": countMap(
+							"The number of nodes exhausted, keyed by resource dimension such as \"memory\" or \"network: reserved port collision\".",
+						),
+						"quota_exhausted": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The quota dimensions that were exhausted.",
+						},
+						"coalesced_failures": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of additional allocations of the task group that failed for the same reasons.",
+						},
+					},
+				},
+			},
+			"class_eligibility": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.BoolType,
+				Description: "Whether each computed node class was eligible for placement.",
+			},
+			"escaped_computed_class": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the job has constraints that escape the computed node class.",
+			},
+			"quota_limit_reached": schema.StringAttribute{
+				Computed:    true,
+				Description: "The quota that was reached, if any.",
+			},
+			"queued_allocations": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The number of allocations queued, keyed by task group name.",
+			},
+			"snapshot_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index of the state snapshot the evaluation was processed against.",
+			},
+			"create_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index at which the evaluation was created.",
+			},
+			"modify_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index at which the evaluation was last modified.",
+			},
+			"create_time": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The time the evaluation was created, in nanoseconds since the Unix epoch.",
+			},
+			"modify_time": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The time the evaluation was last modified, in nanoseconds since the Unix epoch.",
+			},
+		},
+	}
+}
+
+func (d *EvaluationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *EvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data evaluationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	id := data.ID.ValueString()
+	qOpts := &api.QueryOptions{
+		Namespace: data.Namespace.ValueString(),
+		Params:    map[string]string{"related": "true"},
+	}
+
+	tflog.Debug(ctx, "Reading evaluation", map[string]any{"id": id})
+	eval, _, err := client.Evaluations().Info(id, qOpts)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.Diagnostics.AddError("Evaluation not found", fmt.Sprintf("No evaluation found with ID %q.", id))
+			return
+		}
+
+		resp.Diagnostics.AddError("Error reading evaluation", err.Error())
+		return
+	}
+
+	data.Namespace = types.StringValue(eval.Namespace)
+	data.Priority = types.Int64Value(int64(eval.Priority))
+	data.Type = types.StringValue(eval.Type)
+	data.TriggeredBy = types.StringValue(eval.TriggeredBy)
+	data.JobID = types.StringValue(eval.JobID)
+	data.JobModifyIndex = types.Int64Value(int64(eval.JobModifyIndex))
+	data.NodeID = types.StringValue(eval.NodeID)
+	data.DeploymentID = types.StringValue(eval.DeploymentID)
+	data.Status = types.StringValue(eval.Status)
+	data.StatusDescription = types.StringValue(eval.StatusDescription)
+	data.WaitUntil = helper.FormatTime(eval.WaitUntil)
+	data.NextEval = types.StringValue(eval.NextEval)
+	data.PreviousEval = types.StringValue(eval.PreviousEval)
+	data.BlockedEval = types.StringValue(eval.BlockedEval)
+	data.EscapedComputedClass = types.BoolValue(eval.EscapedComputedClass)
+	data.QuotaLimitReached = types.StringValue(eval.QuotaLimitReached)
+	data.SnapshotIndex = types.Int64Value(int64(eval.SnapshotIndex))
+	data.CreateIndex = types.Int64Value(int64(eval.CreateIndex))
+	data.ModifyIndex = types.Int64Value(int64(eval.ModifyIndex))
+	data.CreateTime = types.Int64Value(eval.CreateTime)
+	data.ModifyTime = types.Int64Value(eval.ModifyTime)
+
+	data.RelatedEvals = make([]evaluationStubModel, 0, len(eval.RelatedEvals))
+	for _, stub := range eval.RelatedEvals {
+		data.RelatedEvals = append(data.RelatedEvals, flattenRelatedEvaluation(stub))
+	}
+
+	data.FailedTGAllocs = make(map[string]allocationMetricModel, len(eval.FailedTGAllocs))
+	for tg, metric := range eval.FailedTGAllocs {
+		if metric == nil {
+			continue
+		}
+		data.FailedTGAllocs[tg] = flattenAllocationMetric(metric)
+	}
+
+	data.ClassEligibility = eval.ClassEligibility
+	if data.ClassEligibility == nil {
+		data.ClassEligibility = map[string]bool{}
+	}
+	data.QueuedAllocations = intMapToInt64(eval.QueuedAllocations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenRelatedEvaluation(stub *api.EvaluationStub) evaluationStubModel {
+	return evaluationStubModel{
+		ID:                types.StringValue(stub.ID),
+		Namespace:         types.StringValue(stub.Namespace),
+		Priority:          types.Int64Value(int64(stub.Priority)),
+		Type:              types.StringValue(stub.Type),
+		TriggeredBy:       types.StringValue(stub.TriggeredBy),
+		JobID:             types.StringValue(stub.JobID),
+		NodeID:            types.StringValue(stub.NodeID),
+		DeploymentID:      types.StringValue(stub.DeploymentID),
+		Status:            types.StringValue(stub.Status),
+		StatusDescription: types.StringValue(stub.StatusDescription),
+		WaitUntil:         helper.FormatTime(stub.WaitUntil),
+		NextEval:          types.StringValue(stub.NextEval),
+		PreviousEval:      types.StringValue(stub.PreviousEval),
+		BlockedEval:       types.StringValue(stub.BlockedEval),
+		FailedTaskGroups:  []string{},
+		CreateIndex:       types.Int64Value(int64(stub.CreateIndex)),
+		ModifyIndex:       types.Int64Value(int64(stub.ModifyIndex)),
+		CreateTime:        types.Int64Value(stub.CreateTime),
+		ModifyTime:        types.Int64Value(stub.ModifyTime),
+	}
+}
+
+func flattenAllocationMetric(metric *api.AllocationMetric) allocationMetricModel {
+	quotaExhausted := metric.QuotaExhausted
+	if quotaExhausted == nil {
+		quotaExhausted = []string{}
+	}
+
+	return allocationMetricModel{
+		NodePool:           types.StringValue(metric.NodePool),
+		NodesInPool:        types.Int64Value(int64(metric.NodesInPool)),
+		NodesEvaluated:     types.Int64Value(int64(metric.NodesEvaluated)),
+		NodesFiltered:      types.Int64Value(int64(metric.NodesFiltered)),
+		NodesExhausted:     types.Int64Value(int64(metric.NodesExhausted)),
+		NodesAvailable:     intMapToInt64(metric.NodesAvailable),
+		ClassFiltered:      intMapToInt64(metric.ClassFiltered),
+		ConstraintFiltered: intMapToInt64(metric.ConstraintFiltered),
+		ClassExhausted:     intMapToInt64(metric.ClassExhausted),
+		DimensionExhausted: intMapToInt64(metric.DimensionExhausted),
+		QuotaExhausted:     quotaExhausted,
+		CoalescedFailures:  types.Int64Value(int64(metric.CoalescedFailures)),
+	}
+}
+
+func intMapToInt64(m map[string]int) map[string]int64 {
+	out := make(map[string]int64, len(m))
+	for k, v := range m {
+		out[k] = int64(v)
+	}
+	return out
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evaluations_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+	"github.com/shoenig/test/must"
+)
+
+func TestAccDataSourceNomadEvaluation_failedPlacement(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-eval-ds-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// The evaluation ID is only known once the job is registered,
+				// so it is passed to the configuration as a Terraform variable.
+				PreConfig: func() { t.Setenv("TF_VAR_eval_id", registerUnplaceableJob(t, jobID)) },
+				Config:    testAccDataSourceNomadEvaluationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_evaluation.test", "job_id", jobID),
+					resource.TestCheckResourceAttr("data.nomad_evaluation.test", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.nomad_evaluation.test", "status", "complete"),
+					resource.TestCheckResourceAttrSet("data.nomad_evaluation.test", "blocked_eval"),
+					resource.TestCheckResourceAttr("data.nomad_evaluation.test", "queued_allocations.web", "1"),
+					resource.TestCheckResourceAttrSet("data.nomad_evaluation.test", "failed_tg_allocs.web.nodes_evaluated"),
+					resource.TestCheckResourceAttrSet("data.nomad_evaluation.test", "failed_tg_allocs.web.constraint_filtered.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadEvaluationConfig() string {
+	return `
+variable "eval_id" {
+  type = string
+}
+
+data "nomad_evaluation" "test" {
+  id = var.eval_id
+}
+`
+}
+
+// registerUnplaceableJob registers a job with a constraint no node satisfies
+// and waits for its evaluation to complete, returning the evaluation ID.
+func registerUnplaceableJob(t *testing.T, jobID string) string {
+	t.Helper()
+
+	providerData := testutil.SDKV2ProviderMeta(t)()
+	providerConfig, ok := providerData.(nomad.ProviderConfig)
+	must.True(t, ok, must.Sprintf("expected nomad.ProviderConfig, got %T", providerData))
+
+	client := providerConfig.Client()
+
+	job := &api.Job{
+		ID:          pointerOf(jobID),
+		Name:        pointerOf(jobID),
+		Type:        pointerOf("service"),
+		Datacenters: []string{"dc1"},
+		Constraints: []*api.Constraint{
+			api.NewConstraint("${attr.kernel.name}", "=", "tf-acc-no-such-kernel"),
+		},
+		TaskGroups: []*api.TaskGroup{
+			{
+				Name:  pointerOf("web"),
+				Count: pointerOf(1),
+				Tasks: []*api.Task{
+					{
+						Name:   "server",
+						Driver: "docker",
+						Config: map[string]interface{}{
+							"image": "busybox:1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp, _, err := client.Jobs().Register(job, nil)
+	must.NoError(t, err, must.Sprintf("failed to register test job"))
+
+	t.Cleanup(func() {
+		client.Jobs().Deregister(jobID, true, nil)
+	})
+
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		eval, _, err := client.Evaluations().Info(resp.EvalID, nil)
+		if err == nil && eval.Status == api.EvalStatusComplete {
+			return eval.ID
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	t.Fatalf("evaluation for job %q not complete within timeout", jobID)
+	return ""
+}
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evaluations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &EvaluationsDataSource{}
+var _ datasource.DataSourceWithConfigure = &EvaluationsDataSource{}
+
+type EvaluationsDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewEvaluationsDataSource() datasource.DataSource {
+	return &EvaluationsDataSource{}
+}
+
+type evaluationsModel struct {
+	Namespace     types.String          `tfsdk:"namespace"`
+	Prefix        types.String          `tfsdk:"prefix"`
+	Filter        types.String          `tfsdk:"filter"`
+	JobID         types.String          `tfsdk:"job_id"`
+	Status        types.String          `tfsdk:"status"`
+	PerPage       types.Int64           `tfsdk:"per_page"`
+	NextToken     types.String          `tfsdk:"next_token"`
+	NextPageToken types.String          `tfsdk:"next_page_token"`
+	Evaluations   []evaluationStubModel `tfsdk:"evaluations"`
+}
+
+type evaluationStubModel struct {
+	ID                types.String `tfsdk:"id"`
+	Namespace         types.String `tfsdk:"namespace"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	Type              types.String `tfsdk:"type"`
+	TriggeredBy       types.String `tfsdk:"triggered_by"`
+	JobID             types.String `tfsdk:"job_id"`
+	NodeID            types.String `tfsdk:"node_id"`
+	DeploymentID      types.String `tfsdk:"deployment_id"`
+	Status            types.String `tfsdk:"status"`
+	StatusDescription types.String `tfsdk:"status_description"`
+	WaitUntil         types.String `tfsdk:"wait_until"`
+	NextEval          types.String `tfsdk:"next_eval"`
+	PreviousEval      types.String `tfsdk:"previous_eval"`
+	BlockedEval       types.String `tfsdk:"blocked_eval"`
+	FailedTaskGroups  []string     `tfsdk:"failed_task_groups"`
+	CreateIndex       types.Int64  `tfsdk:"create_index"`
+	ModifyIndex       types.Int64  `tfsdk:"modify_index"`
+	CreateTime        types.Int64  `tfsdk:"create_time"`
+	ModifyTime        types.Int64  `tfsdk:"modify_time"`
+}
+
+// evaluationStubAttributes returns the attributes shared by the evaluations
+// listed by nomad_evaluations and the related evaluations of nomad_evaluation.
+func evaluationStubAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the evaluation.",
+		},
+		"namespace": schema.StringAttribute{
+			Computed:    true,
+			Description: "The namespace of the evaluation.",
+		},
+		"priority": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The priority of the evaluation.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the job that triggered the evaluation.",
+		},
+		"triggered_by": schema.StringAttribute{
+			Computed:    true,
+			Description: "The event that triggered the evaluation.",
+		},
+		"job_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the job the evaluation is for.",
+		},
+		"node_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the node that triggered the evaluation, if any.",
+		},
+		"deployment_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the deployment that triggered the evaluation, if any.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the evaluation.",
+		},
+		"status_description": schema.StringAttribute{
+			Computed:    true,
+			Description: "The reason for the status of the evaluation.",
+		},
+		"wait_until": schema.StringAttribute{
+			Computed:    true,
+			Description: "The time until which the evaluation is delayed.",
+		},
+		"next_eval": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the evaluation that follows this one.",
+		},
+		"previous_eval": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the evaluation that preceded this one.",
+		},
+		"blocked_eval": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the blocked evaluation created because of failed placements.",
+		},
+		"failed_task_groups": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The names of the task groups that could not be fully placed.",
+		},
+		"create_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The Raft index at which the evaluation was created.",
+		},
+		"modify_index": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The Raft index at which the evaluation was last modified.",
+		},
+		"create_time": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The time the evaluation was created, in nanoseconds since the Unix epoch.",
+		},
+		"modify_time": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The time the evaluation was last modified, in nanoseconds since the Unix epoch.",
+		},
+	}
+}
+
+func (d *EvaluationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluations"
+}
+
+func (d *EvaluationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve a list of Nomad evaluations.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "The namespace to list evaluations from. Use \"*\" for all namespaces.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return evaluations whose ID starts with this prefix.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "An expression used to filter the evaluations.",
+			},
+			"job_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return evaluations for this job.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return evaluations with this status, such as \"blocked\" or \"failed\".",
+			},
+			"per_page": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of evaluations to return. Only one page is read, use next_page_token to read the next one. If not set, Nomad returns all the evaluations in a single page.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"next_token": schema.StringAttribute{
+				Optional:    true,
+				Description: "The token of the page to start listing from, as returned in next_page_token.",
+			},
+			"next_page_token": schema.StringAttribute{
+				Computed:    true,
+				Description: "The token to use as next_token to read the next page. Empty when there are no more pages.",
+			},
+			"evaluations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The evaluations found.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: evaluationStubAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *EvaluationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *EvaluationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data evaluationsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	qOpts := &api.QueryOptions{
+		Namespace: data.Namespace.ValueString(),
+		Prefix:    data.Prefix.ValueString(),
+		Filter:    data.Filter.ValueString(),
+		PerPage:   int32(data.PerPage.ValueInt64()),
+		NextToken: data.NextToken.ValueString(),
+		Params:    map[string]string{},
+	}
+	if !data.JobID.IsNull() {
+		qOpts.Params["job"] = data.JobID.ValueString()
+	}
+	if !data.Status.IsNull() {
+		qOpts.Params["status"] = data.Status.ValueString()
+	}
+
+	tflog.Debug(ctx, "Listing evaluations", map[string]any{
+		"namespace": qOpts.Namespace,
+		"filter":    qOpts.Filter,
+	})
+	evals, meta, err := client.Evaluations().List(qOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing evaluations", err.Error())
+		return
+	}
+
+	data.Evaluations = make([]evaluationStubModel, 0, len(evals))
+	for _, eval := range evals {
+		data.Evaluations = append(data.Evaluations, flattenEvaluationStub(eval))
+	}
+
+	data.NextPageToken = types.StringValue("")
+	if meta != nil {
+		data.NextPageToken = types.StringValue(meta.NextToken)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenEvaluationStub(eval *api.Evaluation) evaluationStubModel {
+	failedTGs := make([]string, 0, len(eval.FailedTGAllocs))
+	for tg := range eval.FailedTGAllocs {
+		failedTGs = append(failedTGs, tg)
+	}
+	sort.Strings(failedTGs)
+
+	return evaluationStubModel{
+		ID:                types.StringValue(eval.ID),
+		Namespace:         types.StringValue(eval.Namespace),
+		Priority:          types.Int64Value(int64(eval.Priority)),
+		Type:              types.StringValue(eval.Type),
+		TriggeredBy:       types.StringValue(eval.TriggeredBy),
+		JobID:             types.StringValue(eval.JobID),
+		NodeID:            types.StringValue(eval.NodeID),
+		DeploymentID:      types.StringValue(eval.DeploymentID),
+		Status:            types.StringValue(eval.Status),
+		StatusDescription: types.StringValue(eval.StatusDescription),
+		WaitUntil:         helper.FormatTime(eval.WaitUntil),
+		NextEval:          types.StringValue(eval.NextEval),
+		PreviousEval:      types.StringValue(eval.PreviousEval),
+		BlockedEval:       types.StringValue(eval.BlockedEval),
+		FailedTaskGroups:  failedTGs,
+		CreateIndex:       types.Int64Value(int64(eval.CreateIndex)),
+		ModifyIndex:       types.Int64Value(int64(eval.ModifyIndex)),
+		CreateTime:        types.Int64Value(eval.CreateTime),
+		ModifyTime:        types.Int64Value(eval.ModifyTime),
+	}
+}
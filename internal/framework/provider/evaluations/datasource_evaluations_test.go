@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evaluations_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadEvaluations_basic(t *testing.T) {
+	jobID := fmt.Sprintf("tf-acc-evals-ds-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { registerUnplaceableJob(t, jobID) },
+				Config:    testAccDataSourceNomadEvaluationsConfig(jobID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_evaluations.blocked", "evaluations.#", "1"),
+					resource.TestCheckResourceAttr("data.nomad_evaluations.blocked", "evaluations.0.job_id", jobID),
+					resource.TestCheckResourceAttr("data.nomad_evaluations.blocked", "evaluations.0.status", "blocked"),
+					resource.TestCheckResourceAttr("data.nomad_evaluations.blocked", "evaluations.0.failed_task_groups.0", "web"),
+					resource.TestCheckResourceAttr("data.nomad_evaluations.paged", "evaluations.#", "1"),
+					resource.TestCheckResourceAttrSet("data.nomad_evaluations.paged", "next_page_token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNomadEvaluationsConfig(jobID string) string {
+	return fmt.Sprintf(`
+data "nomad_evaluations" "blocked" {
+  job_id = %[1]q
+  status = "blocked"
+}
+
+data "nomad_evaluations" "paged" {
+  filter   = "JobID == \"%[1]s\""
+  per_page = 1
+}
+`, jobID)
+}
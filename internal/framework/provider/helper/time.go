@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FormatTime returns t in RFC3339 format, or null when t is not set. Nomad
+// reports unset times either as the zero time or as the Unix epoch.
+func FormatTime(t time.Time) types.String {
+	if t.IsZero() || t.UnixNano() == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
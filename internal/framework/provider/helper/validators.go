@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = DurationValidator{}
+
+// DurationValidator validates that a string is parseable as a positive
+// duration.
+type DurationValidator struct{}
+
+func (v DurationValidator) Description(_ context.Context) string {
+	return "value must be a valid duration (e.g., \"1h\", \"30m\")"
+}
+
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v DurationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	s := req.ConfigValue.ValueString()
+	if d, err := time.ParseDuration(s); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration value",
+			fmt.Sprintf("unable to parse %q as a positive duration", s),
+		)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &RootKeysDataSource{}
+var _ datasource.DataSourceWithConfigure = &RootKeysDataSource{}
+
+type RootKeysDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewRootKeysDataSource() datasource.DataSource {
+	return &RootKeysDataSource{}
+}
+
+type rootKeysModel struct {
+	ActiveKeyID types.String   `tfsdk:"active_key_id"`
+	Keys        []rootKeyModel `tfsdk:"keys"`
+}
+
+type rootKeyModel struct {
+	KeyID       types.String `tfsdk:"key_id"`
+	State       types.String `tfsdk:"state"`
+	Algorithm   types.String `tfsdk:"algorithm"`
+	CreateTime  types.String `tfsdk:"create_time"`
+	PublishTime types.String `tfsdk:"publish_time"`
+	CreateIndex types.Int64  `tfsdk:"create_index"`
+	ModifyIndex types.Int64  `tfsdk:"modify_index"`
+}
+
+func (d *RootKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_root_keys"
+}
+
+func (d *RootKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the metadata of the root keys in the Nomad keyring.",
+		Attributes: map[string]schema.Attribute{
+			"active_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the active root key.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The root keys in the keyring.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the root key.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the root key, such as \"active\", \"inactive\" or \"prepublished\".",
+						},
+						"algorithm": schema.StringAttribute{
+							Computed:    true,
+							Description: "The encryption algorithm of the root key.",
+						},
+						"create_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the root key was created.",
+						},
+						"publish_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time a prepublished root key becomes active.",
+						},
+						"create_index": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The Raft index at which the root key was created.",
+						},
+						"modify_index": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The Raft index at which the root key was last modified.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RootKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *RootKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rootKeysModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing root keys")
+	keys, _, err := d.providerConfig.Client().Keyring().List(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing root keys", err.Error())
+		return
+	}
+
+	data.ActiveKeyID = types.StringValue("")
+	data.Keys = make([]rootKeyModel, 0, len(keys))
+	for _, key := range keys {
+		if key.State == api.RootKeyStateActive {
+			data.ActiveKeyID = types.StringValue(key.KeyID)
+		}
+		data.Keys = append(data.Keys, flattenRootKey(key))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenRootKey(key *api.RootKeyMeta) rootKeyModel {
+	return rootKeyModel{
+		KeyID:       types.StringValue(key.KeyID),
+		State:       types.StringValue(string(key.State)),
+		Algorithm:   types.StringValue(string(key.Algorithm)),
+		CreateTime:  helper.FormatTime(time.Unix(0, key.CreateTime)),
+		PublishTime: helper.FormatTime(time.Unix(0, key.PublishTime)),
+		CreateIndex: types.Int64Value(int64(key.CreateIndex)),
+		ModifyIndex: types.Int64Value(int64(key.ModifyIndex)),
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keyring_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadRootKeys_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nomad_root_keys" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nomad_root_keys.test", "active_key_id"),
+					resource.TestCheckResourceAttrSet("data.nomad_root_keys.test", "keys.0.key_id"),
+					resource.TestCheckResourceAttrSet("data.nomad_root_keys.test", "keys.0.algorithm"),
+					resource.TestCheckResourceAttrSet("data.nomad_root_keys.test", "keys.0.create_time"),
+				),
+			},
+		},
+	})
+}
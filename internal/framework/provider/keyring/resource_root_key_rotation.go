@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource              = &RootKeyRotationResource{}
+	_ resource.ResourceWithConfigure = &RootKeyRotationResource{}
+)
+
+type RootKeyRotationResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewRootKeyRotationResource() resource.Resource {
+	return &RootKeyRotationResource{}
+}
+
+type rootKeyRotationModel struct {
+	ID              types.String `tfsdk:"id"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Full            types.Bool   `tfsdk:"full"`
+	Prepublish      types.String `tfsdk:"prepublish"`
+	KeyID           types.String `tfsdk:"key_id"`
+	State           types.String `tfsdk:"state"`
+	CreateTime      types.String `tfsdk:"create_time"`
+	PublishTime     types.String `tfsdk:"publish_time"`
+}
+
+func (r *RootKeyRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_root_key_rotation"
+}
+
+func (r *RootKeyRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the Nomad root key. The key is rotated when the resource is created and again whenever its arguments change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, rotates the root key again.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to re-encrypt all the variables with the new key. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"prepublish": schema.StringAttribute{
+				Optional:    true,
+				Description: "Publish the new key for this duration, such as \"24h\", before it becomes active.",
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the new root key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the new root key when it was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the new root key was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"publish_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the new root key becomes active, when prepublished.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RootKeyRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *RootKeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data rootKeyRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &api.KeyringRotateOptions{Full: data.Full.ValueBool()}
+	if !data.Prepublish.IsNull() {
+		// The duration has already been validated by the schema.
+		prepublish, _ := time.ParseDuration(data.Prepublish.ValueString())
+		opts.PublishTime = time.Now().Add(prepublish).UnixNano()
+	}
+
+	tflog.Debug(ctx, "Rotating root key", map[string]any{"full": opts.Full, "publish_time": opts.PublishTime})
+	key, _, err := r.providerConfig.Client().Keyring().Rotate(opts, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rotating root key", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Rotated root key", map[string]any{"key_id": key.KeyID})
+
+	data.ID = types.StringValue(key.KeyID)
+	data.KeyID = types.StringValue(key.KeyID)
+	data.State = types.StringValue(string(key.State))
+	data.CreateTime = helper.FormatTime(time.Unix(0, key.CreateTime))
+	data.PublishTime = helper.FormatTime(time.Unix(0, key.PublishTime))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op: the resource records a rotation that already happened,
+// the current state of the keys is available from nomad_root_keys.
+func (r *RootKeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data rootKeyRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since all the arguments require
+// replacement, it only stores the plan.
+func (r *RootKeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data rootKeyRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, the root key is left in the
+// keyring.
+func (r *RootKeyRotationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
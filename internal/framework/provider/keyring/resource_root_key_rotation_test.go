@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keyring_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+func TestAccResourceNomadRootKeyRotation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNomadRootKeyRotationConfig("1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_root_key_rotation.test", "state", "active"),
+					testAccCheckRootKeyState(t, api.RootKeyStateActive),
+				),
+			},
+			{
+				Config: testAccResourceNomadRootKeyRotationConfig("2", `prepublish = "1h"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_root_key_rotation.test", "state", "prepublished"),
+					resource.TestCheckResourceAttrSet("nomad_root_key_rotation.test", "publish_time"),
+					testAccCheckRootKeyState(t, api.RootKeyStatePrepublished),
+				),
+			},
+			{
+				Config:      testAccResourceNomadRootKeyRotationConfig("3", `prepublish = "soon"`),
+				ExpectError: regexp.MustCompile("Invalid duration value"),
+			},
+		},
+	})
+}
+
+func testAccResourceNomadRootKeyRotationConfig(trigger, body string) string {
+	return fmt.Sprintf(`
+resource "nomad_root_key_rotation" "test" {
+  rotation_trigger = %q
+  %s
+}
+`, trigger, body)
+}
+
+func testAccCheckRootKeyState(t *testing.T, state api.RootKeyState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["nomad_root_key_rotation.test"]
+		if !ok {
+			return fmt.Errorf("resource nomad_root_key_rotation.test not found in state")
+		}
+
+		client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+		keys, _, err := client.Keyring().List(nil)
+		if err != nil {
+			return fmt.Errorf("error listing root keys: %w", err)
+		}
+		for _, key := range keys {
+			if key.KeyID == rs.Primary.ID {
+				if key.State != state {
+					return fmt.Errorf("expected root key state %q, got %q", state, key.State)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("root key %q not found", rs.Primary.ID)
+	}
+}
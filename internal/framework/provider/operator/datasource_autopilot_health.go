@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &AutopilotHealthDataSource{}
+var _ datasource.DataSourceWithConfigure = &AutopilotHealthDataSource{}
+
+type AutopilotHealthDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewAutopilotHealthDataSource() datasource.DataSource {
+	return &AutopilotHealthDataSource{}
+}
+
+type autopilotHealthModel struct {
+	ID                         types.String              `tfsdk:"id"`
+	Healthy                    types.Bool                `tfsdk:"healthy"`
+	FailureTolerance           types.Int64               `tfsdk:"failure_tolerance"`
+	OptimisticFailureTolerance types.Int64               `tfsdk:"optimistic_failure_tolerance"`
+	Leader                     types.String              `tfsdk:"leader"`
+	Voters                     []string                  `tfsdk:"voters"`
+	ReadReplicas               []string                  `tfsdk:"read_replicas"`
+	Servers                    []autopilotServerModel    `tfsdk:"servers"`
+	RedundancyZones            []autopilotRedundancyZone `tfsdk:"redundancy_zones"`
+}
+
+type autopilotServerModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Address     types.String `tfsdk:"address"`
+	SerfStatus  types.String `tfsdk:"serf_status"`
+	Version     types.String `tfsdk:"version"`
+	Leader      types.Bool   `tfsdk:"leader"`
+	LastContact types.String `tfsdk:"last_contact"`
+	LastTerm    types.Int64  `tfsdk:"last_term"`
+	LastIndex   types.Int64  `tfsdk:"last_index"`
+	Healthy     types.Bool   `tfsdk:"healthy"`
+	Voter       types.Bool   `tfsdk:"voter"`
+	StableSince types.String `tfsdk:"stable_since"`
+}
+
+type autopilotRedundancyZone struct {
+	Name             types.String `tfsdk:"name"`
+	Servers          []string     `tfsdk:"servers"`
+	Voters           []string     `tfsdk:"voters"`
+	FailureTolerance types.Int64  `tfsdk:"failure_tolerance"`
+}
+
+func (d *AutopilotHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_autopilot_health"
+}
+
+func (d *AutopilotHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the health of the Nomad servers as reported by autopilot.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether all the servers are healthy.",
+			},
+			"failure_tolerance": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of redundant healthy servers that could fail without causing an outage.",
+			},
+			"optimistic_failure_tolerance": schema.Int64Attribute{
+				Computed:    true,
+				Description: "(Enterprise-only) The number of healthy servers, including non-voters, that could fail without causing an outage.",
+			},
+			"leader": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the leader server.",
+			},
+			"voters": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the voting servers.",
+			},
+			"read_replicas": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "(Enterprise-only) The IDs of the read replica servers.",
+			},
+			"servers": autopilotServersAttribute(),
+			"redundancy_zones": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "(Enterprise-only) The servers of each redundancy zone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the redundancy zone.",
+						},
+						"servers": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the servers in the zone.",
+						},
+						"voters": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the voting servers in the zone.",
+						},
+						"failure_tolerance": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of servers in the zone that could fail without causing an outage.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// autopilotServersAttribute returns the schema of the servers reported by the
+// autopilot health endpoint.
+func autopilotServersAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The health of each server.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the server.",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the server.",
+				},
+				"address": schema.StringAttribute{
+					Computed:    true,
+					Description: "The address of the server.",
+				},
+				"serf_status": schema.StringAttribute{
+					Computed:    true,
+					Description: "The status of the server in the Serf cluster.",
+				},
+				"version": schema.StringAttribute{
+					Computed:    true,
+					Description: "The Nomad version of the server.",
+				},
+				"leader": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether the server is the leader.",
+				},
+				"last_contact": schema.StringAttribute{
+					Computed:    true,
+					Description: "The time elapsed since the server's last contact with the leader.",
+				},
+				"last_term": schema.Int64Attribute{
+					Computed:    true,
+					Description: "The Raft term of the server's last log entry.",
+				},
+				"last_index": schema.Int64Attribute{
+					Computed:    true,
+					Description: "The Raft index of the server's last log entry.",
+				},
+				"healthy": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether the server is healthy according to the autopilot configuration.",
+				},
+				"voter": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether the server is a voting member of the Raft cluster.",
+				},
+				"stable_since": schema.StringAttribute{
+					Computed:    true,
+					Description: "The time the server last became healthy.",
+				},
+			},
+		},
+	}
+}
+
+func (d *AutopilotHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *AutopilotHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data autopilotHealthModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading autopilot health")
+	health, region, err := readAutopilotHealth(client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading autopilot health", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("nomad-autopilot-health-" + region)
+	data.Healthy = types.BoolValue(health.Healthy)
+	data.FailureTolerance = types.Int64Value(int64(health.FailureTolerance))
+	data.OptimisticFailureTolerance = types.Int64Value(int64(health.OptimisticFailureTolerance))
+	data.Leader = types.StringValue(health.Leader)
+	data.Voters = nonNilStrings(health.Voters)
+	data.ReadReplicas = nonNilStrings(health.ReadReplicas)
+	data.Servers = flattenAutopilotServers(health.Servers)
+	data.RedundancyZones = flattenAutopilotZones(health.RedundancyZones)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readAutopilotHealth returns the autopilot server health and the region of
+// the agent, the data sources reading it use the region in their ID.
+func readAutopilotHealth(client *api.Client) (*api.OperatorHealthReply, string, error) {
+	health, _, err := client.Operator().AutopilotServerHealth(nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	region, err := client.Agent().Region()
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting region: %w", err)
+	}
+	return health, region, nil
+}
+
+func flattenAutopilotServers(servers []api.ServerHealth) []autopilotServerModel {
+	result := make([]autopilotServerModel, 0, len(servers))
+	for _, server := range servers {
+		result = append(result, autopilotServerModel{
+			ID:          types.StringValue(server.ID),
+			Name:        types.StringValue(server.Name),
+			Address:     types.StringValue(server.Address),
+			SerfStatus:  types.StringValue(server.SerfStatus),
+			Version:     types.StringValue(server.Version),
+			Leader:      types.BoolValue(server.Leader),
+			LastContact: types.StringValue(server.LastContact.String()),
+			LastTerm:    types.Int64Value(int64(server.LastTerm)),
+			LastIndex:   types.Int64Value(int64(server.LastIndex)),
+			Healthy:     types.BoolValue(server.Healthy),
+			Voter:       types.BoolValue(server.Voter),
+			StableSince: helper.FormatTime(server.StableSince),
+		})
+	}
+	return result
+}
+
+func flattenAutopilotZones(zones map[string]api.AutopilotZone) []autopilotRedundancyZone {
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]autopilotRedundancyZone, 0, len(zones))
+	for _, name := range names {
+		zone := zones[name]
+		result = append(result, autopilotRedundancyZone{
+			Name:             types.StringValue(name),
+			Servers:          nonNilStrings(zone.Servers),
+			Voters:           nonNilStrings(zone.Voters),
+			FailureTolerance: types.Int64Value(int64(zone.FailureTolerance)),
+		})
+	}
+	return result
+}
+
+// nonNilStrings returns s, or an empty list instead of nil so the attribute
+// is not null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
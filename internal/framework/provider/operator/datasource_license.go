@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &LicenseDataSource{}
+var _ datasource.DataSourceWithConfigure = &LicenseDataSource{}
+
+type LicenseDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewLicenseDataSource() datasource.DataSource {
+	return &LicenseDataSource{}
+}
+
+type licenseModel struct {
+	ID                  types.String `tfsdk:"id"`
+	LicenseID           types.String `tfsdk:"license_id"`
+	CustomerID          types.String `tfsdk:"customer_id"`
+	InstallationID      types.String `tfsdk:"installation_id"`
+	Product             types.String `tfsdk:"product"`
+	NonProduction       types.Bool   `tfsdk:"non_production"`
+	Features            []string     `tfsdk:"features"`
+	Modules             []string     `tfsdk:"modules"`
+	IssueTime           types.String `tfsdk:"issue_time"`
+	StartTime           types.String `tfsdk:"start_time"`
+	ExpirationTime      types.String `tfsdk:"expiration_time"`
+	TerminationTime     types.String `tfsdk:"termination_time"`
+	DaysUntilExpiration types.Int64  `tfsdk:"days_until_expiration"`
+	ConfigOutdated      types.Bool   `tfsdk:"config_outdated"`
+}
+
+func (d *LicenseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+func (d *LicenseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the Nomad Enterprise license in use by the servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"license_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the license.",
+			},
+			"customer_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the customer the license was issued to.",
+			},
+			"installation_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the installation the license is locked to, if any.",
+			},
+			"product": schema.StringAttribute{
+				Computed:    true,
+				Description: "The product the license is valid for.",
+			},
+			"non_production": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the license is for non-production deployments.",
+			},
+			"features": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The features enabled by the license.",
+			},
+			"modules": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The enterprise modules enabled by the license.",
+			},
+			"issue_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the license was issued.",
+			},
+			"start_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the license starts being valid.",
+			},
+			"expiration_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the license expires.",
+			},
+			"termination_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the license stops working.",
+			},
+			"days_until_expiration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of whole days until the license expires. Negative once it has expired.",
+			},
+			"config_outdated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the license in the agent configuration is older than the license in use.",
+			},
+		},
+	}
+}
+
+func (d *LicenseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *LicenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data licenseModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading license")
+	reply, _, err := client.Operator().LicenseGet(nil)
+	if err != nil {
+		detail := err.Error()
+		if strings.Contains(detail, nomad.ErrEnterpriseOnlyEndpoint) {
+			detail = "nomad_license requires Nomad Enterprise, but the cluster runs the community edition"
+		}
+		resp.Diagnostics.AddError("Error reading license", detail)
+		return
+	}
+	if reply == nil || reply.License == nil {
+		resp.Diagnostics.AddError("Error reading license", "no license returned")
+		return
+	}
+
+	license := reply.License
+	data.ID = types.StringValue(client.Address() + "/license")
+	data.LicenseID = types.StringValue(license.LicenseID)
+	data.CustomerID = types.StringValue(license.CustomerID)
+	data.InstallationID = types.StringValue(license.InstallationID)
+	data.Product = types.StringValue(license.Product)
+	data.NonProduction = types.BoolValue(license.NonProduction)
+	data.Features = nonNilStrings(license.Features)
+	data.Modules = nonNilStrings(license.Modules)
+	data.IssueTime = helper.FormatTime(license.IssueTime)
+	data.StartTime = helper.FormatTime(license.StartTime)
+	data.ExpirationTime = helper.FormatTime(license.ExpirationTime)
+	data.TerminationTime = helper.FormatTime(license.TerminationTime)
+	data.DaysUntilExpiration = types.Int64Value(daysUntil(license.ExpirationTime, time.Now()))
+	data.ConfigOutdated = types.BoolValue(reply.ConfigOutdated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// daysUntil returns the number of whole days from now until t, rounded down
+// so that a license is reported as expiring in 0 days on its last day and in
+// -1 days once it has expired.
+func daysUntil(t, now time.Time) int64 {
+	return int64(math.Floor(t.Sub(now).Hours() / 24))
+}
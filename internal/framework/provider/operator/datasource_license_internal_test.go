@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDaysUntil(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		expiration time.Time
+		expected   int64
+	}{
+		{"in a month", now.Add(31 * 24 * time.Hour), 31},
+		{"later today", now.Add(time.Hour), 0},
+		{"an hour ago", now.Add(-time.Hour), -1},
+		{"two days ago", now.Add(-48 * time.Hour), -2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := daysUntil(tc.expiration, now); got != tc.expected {
+				t.Errorf("expected %d days, got %d", tc.expected, got)
+			}
+		})
+	}
+}
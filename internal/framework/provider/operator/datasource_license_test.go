@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadLicense_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testAccPreCheckEnterprise(t)
+		},
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nomad_license" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nomad_license.test", "license_id"),
+					resource.TestCheckResourceAttrSet("data.nomad_license.test", "product"),
+					resource.TestCheckResourceAttrSet("data.nomad_license.test", "expiration_time"),
+					resource.TestCheckResourceAttrSet("data.nomad_license.test", "days_until_expiration"),
+				),
+			},
+		},
+	})
+}
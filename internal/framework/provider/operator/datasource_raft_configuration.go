@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &RaftConfigurationDataSource{}
+var _ datasource.DataSourceWithConfigure = &RaftConfigurationDataSource{}
+
+type RaftConfigurationDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewRaftConfigurationDataSource() datasource.DataSource {
+	return &RaftConfigurationDataSource{}
+}
+
+type raftConfigurationModel struct {
+	ID         types.String      `tfsdk:"id"`
+	Index      types.Int64       `tfsdk:"index"`
+	Leader     types.String      `tfsdk:"leader"`
+	VoterCount types.Int64       `tfsdk:"voter_count"`
+	Servers    []raftServerModel `tfsdk:"servers"`
+}
+
+type raftServerModel struct {
+	ID           types.String `tfsdk:"id"`
+	Node         types.String `tfsdk:"node"`
+	Address      types.String `tfsdk:"address"`
+	Leader       types.Bool   `tfsdk:"leader"`
+	Voter        types.Bool   `tfsdk:"voter"`
+	RaftProtocol types.String `tfsdk:"raft_protocol"`
+}
+
+func (d *RaftConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_raft_configuration"
+}
+
+func (d *RaftConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the Raft configuration of the Nomad servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index of the configuration.",
+			},
+			"leader": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the leader server.",
+			},
+			"voter_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of voting servers.",
+			},
+			"servers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The servers in the Raft configuration.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Raft ID of the server.",
+						},
+						"node": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the server node.",
+						},
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Raft address of the server.",
+						},
+						"leader": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the server is the leader.",
+						},
+						"voter": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the server is a voting member of the Raft cluster.",
+						},
+						"raft_protocol": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Raft protocol version used by the server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RaftConfigurationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *RaftConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data raftConfigurationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading Raft configuration")
+	config, err := client.Operator().RaftGetConfiguration(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Raft configuration", err.Error())
+		return
+	}
+
+	leader := ""
+	voters := 0
+	data.Servers = make([]raftServerModel, 0, len(config.Servers))
+	for _, server := range config.Servers {
+		if server.Leader {
+			leader = server.ID
+		}
+		if server.Voter {
+			voters++
+		}
+		data.Servers = append(data.Servers, raftServerModel{
+			ID:           types.StringValue(server.ID),
+			Node:         types.StringValue(server.Node),
+			Address:      types.StringValue(server.Address),
+			Leader:       types.BoolValue(server.Leader),
+			Voter:        types.BoolValue(server.Voter),
+			RaftProtocol: types.StringValue(server.RaftProtocol),
+		})
+	}
+
+	data.ID = types.StringValue(client.Address() + "/raft-configuration")
+	data.Index = types.Int64Value(int64(config.Index))
+	data.Leader = types.StringValue(leader)
+	data.VoterCount = types.Int64Value(int64(voters))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadRaftConfiguration_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadRaftConfigurationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nomad_raft_configuration.test", "index"),
+					resource.TestCheckResourceAttrSet("data.nomad_raft_configuration.test", "leader"),
+					resource.TestCheckResourceAttr("data.nomad_raft_configuration.test", "servers.0.voter", "true"),
+					resource.TestCheckResourceAttrSet("data.nomad_raft_configuration.test", "servers.0.raft_protocol"),
+					resource.TestCheckResourceAttrPair(
+						"data.nomad_raft_configuration.test", "voter_count",
+						"data.nomad_raft_configuration.test", "servers.#",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNomadRaftConfigurationConfig = `
+data "nomad_raft_configuration" "test" {}
+`
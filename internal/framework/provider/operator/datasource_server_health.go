@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &ServerHealthDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServerHealthDataSource{}
+
+// ServerHealthDataSource summarizes the autopilot server health into values
+// that are simple to check in preconditions. The full report is available
+// from the nomad_autopilot_health data source.
+type ServerHealthDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewServerHealthDataSource() datasource.DataSource {
+	return &ServerHealthDataSource{}
+}
+
+type serverHealthModel struct {
+	ID                 types.String           `tfsdk:"id"`
+	Healthy            types.Bool             `tfsdk:"healthy"`
+	HasLeader          types.Bool             `tfsdk:"has_leader"`
+	Leader             types.String           `tfsdk:"leader"`
+	FailureTolerance   types.Int64            `tfsdk:"failure_tolerance"`
+	ServerCount        types.Int64            `tfsdk:"server_count"`
+	HealthyServerCount types.Int64            `tfsdk:"healthy_server_count"`
+	VoterCount         types.Int64            `tfsdk:"voter_count"`
+	UnhealthyServers   []string               `tfsdk:"unhealthy_servers"`
+	Servers            []autopilotServerModel `tfsdk:"servers"`
+}
+
+func (d *ServerHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_health"
+}
+
+func (d *ServerHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve a summary of the health of the Nomad servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether all the servers are healthy.",
+			},
+			"has_leader": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the cluster has a leader.",
+			},
+			"leader": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the leader server.",
+			},
+			"failure_tolerance": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of redundant healthy servers that could fail without causing an outage.",
+			},
+			"server_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of servers.",
+			},
+			"healthy_server_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of healthy servers.",
+			},
+			"voter_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of voting servers.",
+			},
+			"unhealthy_servers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the servers that are not healthy.",
+			},
+			"servers": autopilotServersAttribute(),
+		},
+	}
+}
+
+func (d *ServerHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *ServerHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverHealthModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.providerConfig.Client()
+
+	tflog.Debug(ctx, "Reading server health")
+	health, region, err := readAutopilotHealth(client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server health", err.Error())
+		return
+	}
+
+	healthy := 0
+	data.UnhealthyServers = []string{}
+	for _, server := range health.Servers {
+		if server.Healthy {
+			healthy++
+		} else {
+			data.UnhealthyServers = append(data.UnhealthyServers, server.Name)
+		}
+	}
+
+	data.ID = types.StringValue("nomad-server-health-" + region)
+	data.Healthy = types.BoolValue(health.Healthy)
+	data.HasLeader = types.BoolValue(health.Leader != "")
+	data.Leader = types.StringValue(health.Leader)
+	data.FailureTolerance = types.Int64Value(int64(health.FailureTolerance))
+	data.ServerCount = types.Int64Value(int64(len(health.Servers)))
+	data.HealthyServerCount = types.Int64Value(int64(healthy))
+	data.VoterCount = types.Int64Value(int64(len(health.Voters)))
+	data.Servers = flattenAutopilotServers(health.Servers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccDataSourceNomadServerHealth_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNomadServerHealthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_server_health.test", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.nomad_server_health.test", "has_leader", "true"),
+					resource.TestCheckResourceAttr("data.nomad_server_health.test", "unhealthy_servers.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						"data.nomad_server_health.test", "healthy_server_count",
+						"data.nomad_server_health.test", "server_count",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNomadServerHealthConfig = `
+data "nomad_server_health" "test" {}
+`
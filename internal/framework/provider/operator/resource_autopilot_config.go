@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource              = &AutopilotConfigResource{}
+	_ resource.ResourceWithConfigure = &AutopilotConfigResource{}
+)
+
+type AutopilotConfigResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewAutopilotConfigResource() resource.Resource {
+	return &AutopilotConfigResource{}
+}
+
+type autopilotConfigModel struct {
+	ID                      types.String `tfsdk:"id"`
+	CleanupDeadServers      types.Bool   `tfsdk:"cleanup_dead_servers"`
+	LastContactThreshold    types.String `tfsdk:"last_contact_threshold"`
+	MaxTrailingLogs         types.Int64  `tfsdk:"max_trailing_logs"`
+	MinQuorum               types.Int64  `tfsdk:"min_quorum"`
+	ServerStabilizationTime types.String `tfsdk:"server_stabilization_time"`
+	EnableRedundancyZones   types.Bool   `tfsdk:"enable_redundancy_zones"`
+	DisableUpgradeMigration types.Bool   `tfsdk:"disable_upgrade_migration"`
+	EnableCustomUpgrades    types.Bool   `tfsdk:"enable_custom_upgrades"`
+	ModifyIndex             types.Int64  `tfsdk:"modify_index"`
+}
+
+func (r *AutopilotConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_autopilot_config"
+}
+
+func (r *AutopilotConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the autopilot configuration of the Nomad servers. Destroying the resource leaves the configuration as-is.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cleanup_dead_servers": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Specifies automatic removal of dead server nodes periodically and whenever a new server is added to the cluster.",
+			},
+			"last_contact_threshold": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("200ms"),
+				Description: "Specifies the maximum amount of time a server can go without contact from the leader before being considered unhealthy.",
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					durationPlanModifier{},
+				},
+			},
+			"max_trailing_logs": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(250),
+				Description: "Specifies the maximum number of log entries that a server can trail the leader by before being considered unhealthy.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_quorum": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Specifies the minimum number of servers needed before autopilot can prune dead servers.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"server_stabilization_time": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("10s"),
+				Description: "Specifies the minimum amount of time a server must be stable in the 'healthy' state before being added to the cluster.",
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					durationPlanModifier{},
+				},
+			},
+			"enable_redundancy_zones": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "(Enterprise-only) Specifies whether to enable redundancy zones.",
+			},
+			"disable_upgrade_migration": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "(Enterprise-only) Disables autopilot's upgrade migration strategy.",
+			},
+			"enable_custom_upgrades": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "(Enterprise-only) Specifies whether to enable using custom upgrade versions when performing migrations.",
+			},
+			"modify_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index at which the autopilot configuration was last modified, used for check-and-set updates.",
+			},
+		},
+	}
+}
+
+func (r *AutopilotConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+// Create writes the configuration with check-and-set against the index of
+// the current configuration, since there is no index in state yet.
+func (r *AutopilotConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data autopilotConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, _, err := r.providerConfig.Client().Operator().AutopilotGetConfiguration(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading autopilot configuration", err.Error())
+		return
+	}
+
+	if err := r.write(ctx, &data, current.ModifyIndex); err != nil {
+		resp.Diagnostics.AddError("Error upserting autopilot configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutopilotConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data autopilotConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading autopilot configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update writes the configuration with check-and-set against the index in
+// the prior state, so changes made outside of Terraform since the last
+// refresh are not silently overwritten. The planned modify_index is unknown
+// since writing the configuration creates a new index.
+func (r *AutopilotConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state autopilotConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.write(ctx, &data, uint64(state.ModifyIndex.ValueInt64())); err != nil {
+		resp.Diagnostics.AddError("Error upserting autopilot configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete does not do anything, since there is no way to know what the
+// configuration was before Terraform managed it.
+func (r *AutopilotConfigResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// write upserts the configuration in data with check-and-set against
+// modifyIndex, and reads it back into data.
+func (r *AutopilotConfigResource) write(ctx context.Context, data *autopilotConfigModel, modifyIndex uint64) error {
+	// The durations have already been validated by the schema.
+	lastContactThreshold, _ := time.ParseDuration(data.LastContactThreshold.ValueString())
+	serverStabilizationTime, _ := time.ParseDuration(data.ServerStabilizationTime.ValueString())
+
+	config := api.AutopilotConfiguration{
+		CleanupDeadServers:      data.CleanupDeadServers.ValueBool(),
+		LastContactThreshold:    lastContactThreshold,
+		MaxTrailingLogs:         uint64(data.MaxTrailingLogs.ValueInt64()),
+		MinQuorum:               uint(data.MinQuorum.ValueInt64()),
+		ServerStabilizationTime: serverStabilizationTime,
+		EnableRedundancyZones:   data.EnableRedundancyZones.ValueBool(),
+		DisableUpgradeMigration: data.DisableUpgradeMigration.ValueBool(),
+		EnableCustomUpgrades:    data.EnableCustomUpgrades.ValueBool(),
+		ModifyIndex:             modifyIndex,
+	}
+
+	tflog.Debug(ctx, "Upserting autopilot configuration", map[string]any{"modify_index": modifyIndex})
+	ok, _, err := r.providerConfig.Client().Operator().AutopilotCASConfiguration(&config, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("the configuration was modified outside of Terraform since it was last read, refresh the state and try again")
+	}
+
+	return r.read(ctx, data)
+}
+
+// read reads the current configuration into data. The durations in data are
+// kept when they are equivalent to the ones returned by Nomad, such as "1m"
+// for "1m0s".
+func (r *AutopilotConfigResource) read(ctx context.Context, data *autopilotConfigModel) error {
+	client := r.providerConfig.Client()
+
+	// The autopilot config doesn't have a UUID, so the resource uses the
+	// agent region.
+	region, err := client.Agent().Region()
+	if err != nil {
+		return fmt.Errorf("error getting region: %w", err)
+	}
+
+	tflog.Debug(ctx, "Reading autopilot configuration")
+	config, _, err := client.Operator().AutopilotGetConfiguration(nil)
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue("nomad-autopilot-configuration-" + region)
+	data.CleanupDeadServers = types.BoolValue(config.CleanupDeadServers)
+	data.LastContactThreshold = durationValue(data.LastContactThreshold, config.LastContactThreshold)
+	data.MaxTrailingLogs = types.Int64Value(int64(config.MaxTrailingLogs))
+	data.MinQuorum = types.Int64Value(int64(config.MinQuorum))
+	data.ServerStabilizationTime = durationValue(data.ServerStabilizationTime, config.ServerStabilizationTime)
+	data.EnableRedundancyZones = types.BoolValue(config.EnableRedundancyZones)
+	data.DisableUpgradeMigration = types.BoolValue(config.DisableUpgradeMigration)
+	data.EnableCustomUpgrades = types.BoolValue(config.EnableCustomUpgrades)
+	data.ModifyIndex = types.Int64Value(int64(config.ModifyIndex))
+	return nil
+}
+
+// durationValue returns prior if it is a duration equal to d, or d formatted
+// otherwise.
+func durationValue(prior types.String, d time.Duration) types.String {
+	if p, err := time.ParseDuration(prior.ValueString()); err == nil && p == d {
+		return prior
+	}
+	return types.StringValue(d.String())
+}
+
+// durationPlanModifier suppresses the diff between equivalent durations,
+// such as "1000ms" in the configuration and "1s" in state.
+type durationPlanModifier struct{}
+
+func (m durationPlanModifier) Description(_ context.Context) string {
+	return "Suppresses diffs when durations are equivalent."
+}
+
+func (m durationPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m durationPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	planned, err := time.ParseDuration(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+	if current, err := time.ParseDuration(req.StateValue.ValueString()); err == nil && planned == current {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+	"github.com/shoenig/test/must"
+)
+
+func TestAccResourceNomadAutopilotConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testResetAutopilotConfiguration(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNomadAutopilotConfigCustom,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "cleanup_dead_servers", "false"),
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "last_contact_threshold", "1s"),
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "max_trailing_logs", "500"),
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "server_stabilization_time", "30s"),
+					resource.TestCheckResourceAttrSet("nomad_autopilot_config.config", "modify_index"),
+				),
+			},
+			{
+				// Durations equivalent to the values returned by Nomad must
+				// not produce a diff.
+				Config:             testAccNomadAutopilotConfigEquivalent,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccNomadAutopilotConfigDefaults,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "cleanup_dead_servers", "true"),
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "last_contact_threshold", "200ms"),
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "max_trailing_logs", "250"),
+					resource.TestCheckResourceAttr("nomad_autopilot_config.config", "server_stabilization_time", "10s"),
+				),
+			},
+			{
+				Config: testAccNomadAutopilotConfigDefaults + testAccNomadAutopilotHealthDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_autopilot_health.health", "healthy", "true"),
+					resource.TestCheckResourceAttrSet("data.nomad_autopilot_health.health", "leader"),
+					resource.TestCheckResourceAttrSet("data.nomad_autopilot_health.health", "servers.0.id"),
+					resource.TestCheckResourceAttr("data.nomad_autopilot_health.health", "servers.0.healthy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNomadAutopilotConfig_modifiedOutsideTerraform(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testResetAutopilotConfiguration(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNomadAutopilotConfigDefaults,
+			},
+			{
+				PreConfig: func() {
+					operator := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client().Operator()
+					config, _, err := operator.AutopilotGetConfiguration(nil)
+					must.NoError(t, err)
+					config.MaxTrailingLogs = 1000
+					_, err = operator.AutopilotSetConfiguration(config, nil)
+					must.NoError(t, err)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccNomadAutopilotConfigDefaults,
+				Check:  resource.TestCheckResourceAttr("nomad_autopilot_config.config", "max_trailing_logs", "250"),
+			},
+		},
+	})
+}
+
+// testResetAutopilotConfiguration restores the default autopilot
+// configuration, since destroying the resource leaves the cluster as-is.
+func testResetAutopilotConfiguration(t *testing.T) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		operator := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client().Operator()
+		_, err := operator.AutopilotSetConfiguration(&api.AutopilotConfiguration{
+			CleanupDeadServers:      true,
+			LastContactThreshold:    200 * time.Millisecond,
+			MaxTrailingLogs:         250,
+			ServerStabilizationTime: 10 * time.Second,
+		}, nil)
+		return err
+	}
+}
+
+const testAccNomadAutopilotConfigCustom = `
+resource "nomad_autopilot_config" "config" {
+  cleanup_dead_servers      = false
+  last_contact_threshold    = "1s"
+  max_trailing_logs         = 500
+  server_stabilization_time = "30s"
+}
+`
+
+const testAccNomadAutopilotConfigEquivalent = `
+resource "nomad_autopilot_config" "config" {
+  cleanup_dead_servers      = false
+  last_contact_threshold    = "1000ms"
+  max_trailing_logs         = 500
+  server_stabilization_time = "0.5m"
+}
+`
+
+const testAccNomadAutopilotConfigDefaults = `
+resource "nomad_autopilot_config" "config" {}
+`
+
+const testAccNomadAutopilotHealthDataSource = `
+data "nomad_autopilot_health" "health" {}
+`
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource              = &OperatorSnapshotResource{}
+	_ resource.ResourceWithConfigure = &OperatorSnapshotResource{}
+)
+
+type OperatorSnapshotResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewOperatorSnapshotResource() resource.Resource {
+	return &OperatorSnapshotResource{}
+}
+
+type operatorSnapshotModel struct {
+	ID       types.String      `tfsdk:"id"`
+	Path     types.String      `tfsdk:"path"`
+	Stale    types.Bool        `tfsdk:"stale"`
+	Triggers map[string]string `tfsdk:"triggers"`
+	Index    types.Int64       `tfsdk:"index"`
+	Size     types.Int64       `tfsdk:"size"`
+	Checksum types.String      `tfsdk:"checksum"`
+}
+
+func (r *OperatorSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operator_snapshot"
+}
+
+func (r *OperatorSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Takes a snapshot of the Nomad server state and writes it to a local file. A new snapshot is taken when the file is missing or was modified, or when triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The local path the snapshot archive is written to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stale": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether any server may take the snapshot instead of only the leader. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, take a new snapshot.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index of the snapshot.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the snapshot archive in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hex-encoded SHA-256 checksum of the snapshot archive.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OperatorSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *OperatorSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data operatorSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Stale.IsUnknown() {
+		data.Stale = types.BoolValue(false)
+	}
+	path := data.Path.ValueString()
+
+	tflog.Debug(ctx, "Taking snapshot", map[string]any{"path": path, "stale": data.Stale.ValueBool()})
+	snapshot, err := r.providerConfig.Client().Operator().Snapshot(&api.QueryOptions{
+		AllowStale: data.Stale.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error taking snapshot", err.Error())
+		return
+	}
+	defer snapshot.Close()
+
+	size, checksum, err := writeSnapshot(path, snapshot)
+	if err != nil {
+		resp.Diagnostics.AddError("Error writing snapshot", fmt.Sprintf("error writing snapshot to %q: %s", path, err))
+		return
+	}
+
+	index, err := snapshotIndex(path)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading snapshot", fmt.Sprintf("error reading snapshot %q: %s", path, err))
+		return
+	}
+	tflog.Debug(ctx, "Took snapshot", map[string]any{"path": path, "index": index, "size": size})
+
+	data.ID = data.Path
+	data.Index = types.Int64Value(int64(index))
+	data.Size = types.Int64Value(size)
+	data.Checksum = types.StringValue(checksum)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read verifies the snapshot archive against the checksum in state. The
+// resource is removed from state when the file is missing or was modified so
+// that a new snapshot is taken.
+func (r *OperatorSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data operatorSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := data.Path.ValueString()
+	checksum, err := fileChecksum(path)
+	if errors.Is(err, os.ErrNotExist) {
+		tflog.Debug(ctx, "Snapshot not found, removing from state", map[string]any{"path": path})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading snapshot", fmt.Sprintf("error reading snapshot %q: %s", path, err))
+		return
+	}
+	if checksum != data.Checksum.ValueString() {
+		tflog.Debug(ctx, "Snapshot checksum mismatch, removing from state", map[string]any{
+			"path":     path,
+			"expected": data.Checksum.ValueString(),
+			"found":    checksum,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since all the arguments require
+// replacement, it only stores the plan.
+func (r *OperatorSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data operatorSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, the snapshot archive is kept
+// so destroying the configuration does not lose the backup.
+func (r *OperatorSnapshotResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// writeSnapshot writes the snapshot read from r to path and returns its size
+// and hex-encoded SHA-256 checksum. The snapshot is written to a temporary
+// file that is only renamed to path once it has been fully read, which is
+// when the client verifies the digest sent by Nomad, so a truncated or
+// corrupted snapshot never replaces an existing archive.
+func writeSnapshot(path string, r io.Reader) (int64, string, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, "", err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return 0, "", err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := tmp.Sync(); err != nil {
+		return 0, "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, "", err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fileChecksum returns the hex-encoded SHA-256 checksum of the file at path.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// snapshotIndex returns the Raft index recorded in the meta.json file of the
+// snapshot archive at path.
+func snapshotIndex(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	defer gz.Close()
+
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return 0, errors.New("invalid snapshot archive: meta.json not found")
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid snapshot archive: %w", err)
+		}
+		if header.Name != "meta.json" {
+			continue
+		}
+
+		var meta struct {
+			Index uint64
+		}
+		if err := json.NewDecoder(archive).Decode(&meta); err != nil {
+			return 0, fmt.Errorf("invalid snapshot metadata: %w", err)
+		}
+		return meta.Index, nil
+	}
+}
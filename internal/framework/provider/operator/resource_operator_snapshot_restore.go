@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource                   = &OperatorSnapshotRestoreResource{}
+	_ resource.ResourceWithConfigure      = &OperatorSnapshotRestoreResource{}
+	_ resource.ResourceWithValidateConfig = &OperatorSnapshotRestoreResource{}
+)
+
+type OperatorSnapshotRestoreResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewOperatorSnapshotRestoreResource() resource.Resource {
+	return &OperatorSnapshotRestoreResource{}
+}
+
+type operatorSnapshotRestoreModel struct {
+	ID             types.String      `tfsdk:"id"`
+	Path           types.String      `tfsdk:"path"`
+	Checksum       types.String      `tfsdk:"checksum"`
+	ConfirmRestore types.Bool        `tfsdk:"confirm_restore"`
+	Triggers       map[string]string `tfsdk:"triggers"`
+	Index          types.Int64       `tfsdk:"index"`
+}
+
+func (r *OperatorSnapshotRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operator_snapshot_restore"
+}
+
+func (r *OperatorSnapshotRestoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores the Nomad server state from a snapshot archive. The snapshot is restored when the resource is created and again whenever its arguments change. Restoring a snapshot overwrites the state of the whole cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The local path of the snapshot archive to restore.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Optional:    true,
+				Description: "The expected hex-encoded SHA-256 checksum of the snapshot archive. The snapshot is not restored if the archive does not match.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm_restore": schema.BoolAttribute{
+				Required:    true,
+				Description: "Must be set to true to acknowledge that creating or replacing the resource overwrites the state of the whole cluster.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, restore the snapshot again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index of the restored snapshot.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OperatorSnapshotRestoreResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data operatorSnapshotRestoreModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ConfirmRestore.IsNull() || data.ConfirmRestore.IsUnknown() {
+		return
+	}
+
+	if !data.ConfirmRestore.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("confirm_restore"), "Restore not confirmed",
+			"confirm_restore must be set to true. Creating or replacing this resource overwrites the state of the whole cluster.")
+	}
+}
+
+func (r *OperatorSnapshotRestoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *OperatorSnapshotRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data operatorSnapshotRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotPath := data.Path.ValueString()
+
+	// Check the archive before sending it, a restore cannot be undone.
+	if !data.Checksum.IsNull() {
+		checksum, err := fileChecksum(snapshotPath)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading snapshot", fmt.Sprintf("error reading snapshot %q: %s", snapshotPath, err))
+			return
+		}
+		if checksum != data.Checksum.ValueString() {
+			resp.Diagnostics.AddAttributeError(path.Root("checksum"), "Snapshot checksum mismatch",
+				fmt.Sprintf("The checksum of %q is %s, expected %s. The snapshot was not restored.", snapshotPath, checksum, data.Checksum.ValueString()))
+			return
+		}
+	}
+
+	index, err := snapshotIndex(snapshotPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading snapshot", fmt.Sprintf("error reading snapshot %q: %s", snapshotPath, err))
+		return
+	}
+
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading snapshot", fmt.Sprintf("error reading snapshot %q: %s", snapshotPath, err))
+		return
+	}
+	defer f.Close()
+
+	tflog.Debug(ctx, "Restoring snapshot", map[string]any{"path": snapshotPath, "index": index})
+	if _, err := r.providerConfig.Client().Operator().SnapshotRestore(f, &api.WriteOptions{}); err != nil {
+		resp.Diagnostics.AddError("Error restoring snapshot", fmt.Sprintf("error restoring snapshot %q: %s", snapshotPath, err))
+		return
+	}
+	tflog.Debug(ctx, "Restored snapshot", map[string]any{"path": snapshotPath, "index": index})
+
+	data.ID = data.Path
+	data.Index = types.Int64Value(int64(index))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op: the resource records a restore that already happened, so
+// there is nothing in Nomad to refresh it against.
+func (r *OperatorSnapshotRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data operatorSnapshotRestoreModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since all the arguments require
+// replacement, it only stores the plan.
+func (r *OperatorSnapshotRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data operatorSnapshotRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, the cluster state is left as
+// it is.
+func (r *OperatorSnapshotRestoreResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
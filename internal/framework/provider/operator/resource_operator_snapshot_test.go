@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+)
+
+func TestAccResourceNomadOperatorSnapshot_basic(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "backup.snap")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNomadOperatorSnapshotConfig(snapshotPath, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_operator_snapshot.test", "path", snapshotPath),
+					resource.TestCheckResourceAttrSet("nomad_operator_snapshot.test", "index"),
+					resource.TestCheckResourceAttrSet("nomad_operator_snapshot.test", "size"),
+					resource.TestCheckResourceAttrSet("nomad_operator_snapshot.test", "checksum"),
+					testAccCheckSnapshotFile(snapshotPath),
+				),
+			},
+			{
+				// Removing the archive takes a new snapshot.
+				PreConfig: func() { os.Remove(snapshotPath) },
+				Config:    testAccResourceNomadOperatorSnapshotConfig(snapshotPath, "1"),
+				Check:     testAccCheckSnapshotFile(snapshotPath),
+			},
+			{
+				Config: testAccResourceNomadOperatorSnapshotConfig(snapshotPath, "2"),
+				Check:  testAccCheckSnapshotFile(snapshotPath),
+			},
+		},
+	})
+}
+
+func TestAccResourceNomadOperatorSnapshotRestore_checksumMismatch(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "backup.snap")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNomadOperatorSnapshotConfig(snapshotPath, "1") + `
+resource "nomad_operator_snapshot_restore" "test" {
+  path            = nomad_operator_snapshot.test.path
+  checksum        = "0000000000000000000000000000000000000000000000000000000000000000"
+  confirm_restore = true
+}
+`,
+				ExpectError: regexp.MustCompile("Snapshot checksum mismatch"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNomadOperatorSnapshotRestore_notConfirmed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nomad_operator_snapshot_restore" "test" {
+  path            = "backup.snap"
+  confirm_restore = false
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Restore not confirmed"),
+			},
+		},
+	})
+}
+
+func testAccResourceNomadOperatorSnapshotConfig(path, trigger string) string {
+	return fmt.Sprintf(`
+resource "nomad_operator_snapshot" "test" {
+  path = %q
+
+  triggers = {
+    run = %q
+  }
+}
+`, path, trigger)
+}
+
+func testAccCheckSnapshotFile(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["nomad_operator_snapshot.test"]
+		if !ok {
+			return fmt.Errorf("resource nomad_operator_snapshot.test not found in state")
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("error reading snapshot: %w", err)
+		}
+		if size := fmt.Sprint(info.Size()); size != rs.Primary.Attributes["size"] {
+			return fmt.Errorf("expected snapshot size %s, got %s", rs.Primary.Attributes["size"], size)
+		}
+		return nil
+	}
+}
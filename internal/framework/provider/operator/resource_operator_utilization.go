@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource              = &OperatorUtilizationResource{}
+	_ resource.ResourceWithConfigure = &OperatorUtilizationResource{}
+)
+
+// OperatorUtilizationResource generates a utilization reporting bundle when
+// it is created. The endpoint records a new report every time it is called,
+// so it is a resource driven by triggers instead of a data source read on
+// every plan.
+type OperatorUtilizationResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewOperatorUtilizationResource() resource.Resource {
+	return &OperatorUtilizationResource{}
+}
+
+type operatorUtilizationModel struct {
+	ID        types.String      `tfsdk:"id"`
+	TodayOnly types.Bool        `tfsdk:"today_only"`
+	Path      types.String      `tfsdk:"path"`
+	Triggers  map[string]string `tfsdk:"triggers"`
+	Bundle    types.String      `tfsdk:"bundle"`
+	Size      types.Int64       `tfsdk:"size"`
+	Checksum  types.String      `tfsdk:"checksum"`
+	Index     types.Int64       `tfsdk:"index"`
+}
+
+func (r *OperatorUtilizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operator_utilization"
+}
+
+func (r *OperatorUtilizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a Nomad Enterprise utilization reporting bundle. A new bundle is generated when the file at path is missing or was modified, or when triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"today_only": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Only include the utilization of the current day. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "A local path the bundle is also written to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, generate a new bundle.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"bundle": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The utilization reporting bundle, base64-encoded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the bundle in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hex-encoded SHA-256 checksum of the bundle.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Raft index at which the bundle was generated.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OperatorUtilizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *OperatorUtilizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data operatorUtilizationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerConfig.CheckServerVersion("the utilization reporting API", "1.10.0"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Nomad version", err.Error())
+		return
+	}
+
+	client := r.providerConfig.Client()
+
+	tflog.Debug(ctx, "Generating utilization bundle", map[string]any{"today_only": data.TodayOnly.ValueBool()})
+	report, wm, err := client.Operator().Utilization(&api.OperatorUtilizationOptions{
+		TodayOnly: data.TodayOnly.ValueBool(),
+	}, nil)
+	if err != nil {
+		detail := err.Error()
+		if strings.Contains(detail, "501") {
+			detail = "The utilization reporting API requires Nomad Enterprise: " + detail
+		}
+		resp.Diagnostics.AddError("Error generating utilization bundle", detail)
+		return
+	}
+
+	if !data.Path.IsNull() {
+		path := data.Path.ValueString()
+		if _, _, err := writeSnapshot(path, bytes.NewReader(report.Bundle)); err != nil {
+			resp.Diagnostics.AddError("Error writing utilization bundle", fmt.Sprintf("error writing utilization bundle to %q: %s", path, err))
+			return
+		}
+	}
+
+	checksum := sha256.Sum256(report.Bundle)
+	data.Bundle = types.StringValue(base64.StdEncoding.EncodeToString(report.Bundle))
+	data.Size = types.Int64Value(int64(len(report.Bundle)))
+	data.Checksum = types.StringValue(hex.EncodeToString(checksum[:]))
+	data.Index = types.Int64Value(0)
+	if wm != nil {
+		data.Index = types.Int64Value(int64(wm.LastIndex))
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/utilization/%d", client.Address(), data.Index.ValueInt64()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read verifies the bundle written to path against the checksum in state. The
+// resource is removed from state when the file is missing or was modified so
+// that a new bundle is generated.
+func (r *OperatorUtilizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data operatorUtilizationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Path.IsNull() {
+		path := data.Path.ValueString()
+		checksum, err := fileChecksum(path)
+		if errors.Is(err, os.ErrNotExist) {
+			tflog.Debug(ctx, "Utilization bundle not found, removing from state", map[string]any{"path": path})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading utilization bundle", fmt.Sprintf("error reading utilization bundle %q: %s", path, err))
+			return
+		}
+		if checksum != data.Checksum.ValueString() {
+			tflog.Debug(ctx, "Utilization bundle checksum mismatch, removing from state", map[string]any{"path": path})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since all the arguments require
+// replacement, it only stores the plan.
+func (r *OperatorUtilizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data operatorUtilizationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, the bundle written to path is
+// kept for the audit trail.
+func (r *OperatorUtilizationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package operator_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+func TestAccResourceNomadOperatorUtilization_basic(t *testing.T) {
+	bundlePath := filepath.Join(t.TempDir(), "utilization.json")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testAccPreCheckEnterprise(t)
+		},
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "nomad_operator_utilization" "test" {
+  today_only = true
+  path       = %q
+
+  triggers = {
+    month = "2026-01"
+  }
+}
+`, bundlePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nomad_operator_utilization.test", "bundle"),
+					resource.TestCheckResourceAttrSet("nomad_operator_utilization.test", "id"),
+					resource.TestCheckResourceAttrSet("nomad_operator_utilization.test", "checksum"),
+					testAccCheckUtilizationBundleFile(bundlePath),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUtilizationBundleFile(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["nomad_operator_utilization.test"]
+		if !ok {
+			return fmt.Errorf("resource nomad_operator_utilization.test not found in state")
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("error reading utilization bundle: %w", err)
+		}
+		if size := fmt.Sprint(info.Size()); size != rs.Primary.Attributes["size"] {
+			return fmt.Errorf("expected utilization bundle size %s, got %s", rs.Primary.Attributes["size"], size)
+		}
+		return nil
+	}
+}
+
+func testAccPreCheckEnterprise(t *testing.T) {
+	t.Helper()
+	client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+	if _, _, err := client.Operator().LicenseGet(nil); err != nil {
+		t.Skipf("test requires Nomad Enterprise: %v", err)
+	}
+}
@@ -13,6 +13,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/acl"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/agent"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/allocations"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/deployments"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/evaluations"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/keyring"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/operator"
 	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/services"
 	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/variables"
 	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/volumes"
@@ -143,21 +149,51 @@ func (p *NomadProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		acl.NewACLAuthMethodResource,
 		acl.NewACLBindingRuleResource,
+		acl.NewACLBootstrapResource,
+		acl.NewACLTokenRotationResource,
+		acl.NewACLTokenCleanupResource,
+		allocations.NewAllocationActionResource,
+		deployments.NewDeploymentControlResource,
+		keyring.NewRootKeyRotationResource,
+		operator.NewAutopilotConfigResource,
+		operator.NewOperatorSnapshotResource,
+		operator.NewOperatorSnapshotRestoreResource,
+		operator.NewOperatorUtilizationResource,
 		volumes.NewCSIVolumeResource,
+		volumes.NewCSIVolumeDetachResource,
 		volumes.NewCSIVolumeRegistrationResource,
+		volumes.NewCSIVolumeSnapshotResource,
 	}
 }
 
 func (p *NomadProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		acl.NewACLBindingRulePreviewDataSource,
+		acl.NewACLPolicyCheckDataSource,
+		acl.NewACLTokenSelfDataSource,
+		agent.NewAgentMembersDataSource,
+		agent.NewAgentSelfDataSource,
+		allocations.NewAllocationDataSource,
+		deployments.NewDeploymentDataSource,
+		deployments.NewDeploymentsDataSource,
+		evaluations.NewEvaluationDataSource,
+		evaluations.NewEvaluationsDataSource,
+		keyring.NewRootKeysDataSource,
+		operator.NewAutopilotHealthDataSource,
+		operator.NewLicenseDataSource,
+		operator.NewRaftConfigurationDataSource,
+		operator.NewServerHealthDataSource,
 		services.NewServiceDataSource,
 		services.NewServicesDataSource,
+		volumes.NewCSIVolumeSnapshotsDataSource,
 	}
 }
 
 func (p *NomadProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
+		allocations.NewAllocationFileEphemeralResource,
 		acl.NewIntroTokenEphemeralResource,
+		acl.NewACLOIDCLoginEphemeralResource,
 		acl.NewACLTokenEphemeralResource,
 		variables.NewVariableEphemeralResource,
 	}
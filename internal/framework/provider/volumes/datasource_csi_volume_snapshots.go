@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var _ datasource.DataSource = &CSIVolumeSnapshotsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CSIVolumeSnapshotsDataSource{}
+
+type CSIVolumeSnapshotsDataSource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewCSIVolumeSnapshotsDataSource() datasource.DataSource {
+	return &CSIVolumeSnapshotsDataSource{}
+}
+
+type csiVolumeSnapshotsModel struct {
+	PluginID      types.String            `tfsdk:"plugin_id"`
+	Secrets       map[string]types.String `tfsdk:"secrets"`
+	PerPage       types.Int64             `tfsdk:"per_page"`
+	NextToken     types.String            `tfsdk:"next_token"`
+	NextPageToken types.String            `tfsdk:"next_page_token"`
+	Snapshots     []csiSnapshotModel      `tfsdk:"snapshots"`
+}
+
+type csiSnapshotModel struct {
+	ID                     types.String `tfsdk:"id"`
+	PluginID               types.String `tfsdk:"plugin_id"`
+	SourceVolumeID         types.String `tfsdk:"source_volume_id"`
+	ExternalSourceVolumeID types.String `tfsdk:"external_source_volume_id"`
+	SizeBytes              types.Int64  `tfsdk:"size_bytes"`
+	CreateTime             types.String `tfsdk:"create_time"`
+	IsReady                types.Bool   `tfsdk:"is_ready"`
+}
+
+func (d *CSIVolumeSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_csi_volume_snapshots"
+}
+
+func (d *CSIVolumeSnapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the snapshots known to the storage provider of a CSI plugin.",
+		Attributes: map[string]schema.Attribute{
+			"plugin_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the CSI plugin to list the snapshots of.",
+			},
+			"secrets": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "An optional key-value map of strings used as credentials to list the snapshots.",
+			},
+			"per_page": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of snapshots to return. Defaults to all the snapshots the plugin returns in one page.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"next_token": schema.StringAttribute{
+				Optional:    true,
+				Description: "The token returned in next_page_token by a previous read, to list the next page of snapshots.",
+			},
+			"next_page_token": schema.StringAttribute{
+				Computed:    true,
+				Description: "The token to use as next_token to list the next page of snapshots. Empty on the last page.",
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The snapshots, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the snapshot, as assigned by the storage provider.",
+						},
+						"plugin_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the CSI plugin that manages the snapshot.",
+						},
+						"source_volume_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Nomad CSI volume the snapshot was created from, if known.",
+						},
+						"external_source_volume_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the source volume, as known by the storage provider.",
+						},
+						"size_bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size of the snapshot in bytes.",
+						},
+						"create_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the snapshot was created, in RFC3339 format.",
+						},
+						"is_ready": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the snapshot is ready to be used to create a volume.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CSIVolumeSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *CSIVolumeSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data csiVolumeSnapshotsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := &api.CSISnapshotListRequest{
+		PluginID: data.PluginID.ValueString(),
+		Secrets:  toMapStringString(data.Secrets),
+		QueryOptions: api.QueryOptions{
+			PerPage:   int32(data.PerPage.ValueInt64()),
+			NextToken: data.NextToken.ValueString(),
+		},
+	}
+
+	tflog.Debug(ctx, "Listing CSI volume snapshots", map[string]any{"plugin_id": listReq.PluginID})
+	listResp, _, err := d.providerConfig.Client().CSIVolumes().ListSnapshotsOpts(listReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing CSI volume snapshots", err.Error())
+		return
+	}
+
+	data.Snapshots = make([]csiSnapshotModel, 0, len(listResp.Snapshots))
+	for _, snap := range listResp.Snapshots {
+		data.Snapshots = append(data.Snapshots, csiSnapshotModel{
+			ID:                     types.StringValue(snap.ID),
+			PluginID:               types.StringValue(snap.PluginID),
+			SourceVolumeID:         types.StringValue(snap.SourceVolumeID),
+			ExternalSourceVolumeID: types.StringValue(snap.ExternalSourceVolumeID),
+			SizeBytes:              types.Int64Value(snap.SizeBytes),
+			CreateTime:             helper.FormatTime(time.Unix(snap.CreateTime, 0)),
+			IsReady:                types.BoolValue(snap.IsReady),
+		})
+	}
+	data.NextPageToken = types.StringValue(listResp.NextToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dustin/go-humanize"
@@ -663,6 +664,48 @@ func computedAttributes() map[string]schema.Attribute {
 				listplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"read_allocations": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The IDs of the allocations holding a read claim on the volume.",
+		},
+		"write_allocations": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The IDs of the allocations holding a write claim on the volume.",
+		},
+		"claims": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The allocations holding a claim on the volume.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"allocation_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the allocation.",
+					},
+					"node_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the node the allocation runs on.",
+					},
+					"job_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the job of the allocation.",
+					},
+					"task_group": schema.StringAttribute{
+						Computed:    true,
+						Description: "The task group of the allocation.",
+					},
+					"client_status": schema.StringAttribute{
+						Computed:    true,
+						Description: "The client status of the allocation.",
+					},
+					"mode": schema.StringAttribute{
+						Computed:    true,
+						Description: "The mode of the claim, either \"read\" or \"write\".",
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -759,6 +802,64 @@ func flattenTopologiesToList(topos []*api.CSITopology) types.List {
 	return types.ListValueMust(types.ObjectType{AttrTypes: topologyAttrTypes}, elems)
 }
 
+var claimAttrTypes = map[string]attr.Type{
+	"allocation_id": types.StringType,
+	"node_id":       types.StringType,
+	"job_id":        types.StringType,
+	"task_group":    types.StringType,
+	"client_status": types.StringType,
+	"mode":          types.StringType,
+}
+
+// flattenClaims returns the IDs of the allocations holding a read and a write
+// claim on the volume, and the details of each claim. Nomad only returns the
+// IDs in ReadAllocs and WriteAllocs, the allocations are listed separately in
+// Allocations.
+func flattenClaims(volume *api.CSIVolume) (types.List, types.List, types.List) {
+	readAllocs := sortedAllocIDs(volume.ReadAllocs)
+	writeAllocs := sortedAllocIDs(volume.WriteAllocs)
+
+	claims := make([]attr.Value, 0, len(volume.Allocations))
+	for _, alloc := range volume.Allocations {
+		if alloc == nil {
+			continue
+		}
+		mode := "read"
+		if _, ok := volume.WriteAllocs[alloc.ID]; ok {
+			mode = "write"
+		}
+		claims = append(claims, types.ObjectValueMust(claimAttrTypes, map[string]attr.Value{
+			"allocation_id": types.StringValue(alloc.ID),
+			"node_id":       types.StringValue(alloc.NodeID),
+			"job_id":        types.StringValue(alloc.JobID),
+			"task_group":    types.StringValue(alloc.TaskGroup),
+			"client_status": types.StringValue(alloc.ClientStatus),
+			"mode":          types.StringValue(mode),
+		}))
+	}
+
+	return stringsToListValue(readAllocs),
+		stringsToListValue(writeAllocs),
+		types.ListValueMust(types.ObjectType{AttrTypes: claimAttrTypes}, claims)
+}
+
+func sortedAllocIDs(allocs map[string]*api.Allocation) []string {
+	ids := make([]string, 0, len(allocs))
+	for id := range allocs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func stringsToListValue(s []string) types.List {
+	elems := make([]attr.Value, 0, len(s))
+	for _, v := range s {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
 func flattenTopologyRequests(req *api.CSITopologyRequest) []topologyRequestModel {
 	if req == nil {
 		return nil
@@ -60,6 +60,9 @@ type csiVolumeModel struct {
 	NodesExpected         types.Int64  `tfsdk:"nodes_expected"`
 	Schedulable           types.Bool   `tfsdk:"schedulable"`
 	Topologies            types.List   `tfsdk:"topologies"`
+	ReadAllocations       types.List   `tfsdk:"read_allocations"`
+	WriteAllocations      types.List   `tfsdk:"write_allocations"`
+	Claims                types.List   `tfsdk:"claims"`
 	ExternalID            types.String `tfsdk:"external_id"`
 	Context               types.Map    `tfsdk:"context"`
 }
@@ -594,6 +597,7 @@ func (r *CSIVolumeResource) readVolumeIntoModel(ctx context.Context, client *api
 
 	data.Capability = flattenCapabilities(volume.RequestedCapabilities)
 	data.Topologies = flattenTopologiesToList(volume.Topologies)
+	data.ReadAllocations, data.WriteAllocations, data.Claims = flattenClaims(volume)
 
 	if volume.RequestedTopologies != nil {
 		data.TopologyRequest = flattenTopologyRequests(volume.RequestedTopologies)
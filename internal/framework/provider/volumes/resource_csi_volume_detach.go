@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package volumes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+var (
+	_ resource.Resource              = &CSIVolumeDetachResource{}
+	_ resource.ResourceWithConfigure = &CSIVolumeDetachResource{}
+)
+
+type CSIVolumeDetachResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewCSIVolumeDetachResource() resource.Resource {
+	return &CSIVolumeDetachResource{}
+}
+
+type csiVolumeDetachModel struct {
+	ID        types.String      `tfsdk:"id"`
+	Namespace types.String      `tfsdk:"namespace"`
+	VolumeID  types.String      `tfsdk:"volume_id"`
+	NodeID    types.String      `tfsdk:"node_id"`
+	Triggers  map[string]string `tfsdk:"triggers"`
+}
+
+func (r *CSIVolumeDetachResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_csi_volume_detach"
+}
+
+func (r *CSIVolumeDetachResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detaches a CSI volume from a node, releasing the claims of the allocations on the node. The volume is detached when the resource is created and again whenever its arguments change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				Description: "The namespace of the volume.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the volume to detach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the node to detach the volume from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, detach the volume again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CSIVolumeDetachResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+	r.providerConfig = providerConfig
+}
+
+func (r *CSIVolumeDetachResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data csiVolumeDetachModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeID := data.VolumeID.ValueString()
+	nodeID := data.NodeID.ValueString()
+	ns := data.Namespace.ValueString()
+
+	tflog.Debug(ctx, "Detaching CSI volume", map[string]any{"volume_id": volumeID, "node_id": nodeID, "namespace": ns})
+	err := r.providerConfig.Client().CSIVolumes().Detach(volumeID, nodeID, &api.WriteOptions{Namespace: ns})
+	if err != nil {
+		resp.Diagnostics.AddError("Error detaching CSI volume", fmt.Sprintf("error detaching CSI volume %q from node %q: %s", volumeID, nodeID, err))
+		return
+	}
+	tflog.Debug(ctx, "Detached CSI volume", map[string]any{"volume_id": volumeID, "node_id": nodeID})
+
+	data.ID = types.StringValue(volumeID + "/" + nodeID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op: the resource records a detach that already happened, the
+// volume may be claimed again afterwards.
+func (r *CSIVolumeDetachResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data csiVolumeDetachModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since all the arguments require
+// replacement, it only stores the plan.
+func (r *CSIVolumeDetachResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data csiVolumeDetachModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, the volume is not attached
+// again.
+func (r *CSIVolumeDetachResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
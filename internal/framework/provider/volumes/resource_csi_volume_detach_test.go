@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package volumes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+	"github.com/shoenig/test/must"
+)
+
+func TestResourceCSIVolumeDetach_basic(t *testing.T) {
+	// The node is only known once the pre-checks ran, the map is shared with
+	// the steps.
+	vars := config.Variables{}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testCheckCSIPluginAvailable(t, "hostpath-plugin0")
+
+			plugin, _, err := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client().CSIPlugins().Info("hostpath-plugin0", nil)
+			must.NoError(t, err)
+			for nodeID := range plugin.Nodes {
+				vars["node_id"] = config.StringVariable(nodeID)
+				break
+			}
+			must.MapContainsKey(t, vars, "node_id")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:          testCSIVolumeDetachConfig("1"),
+				ConfigVariables: vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_csi_volume_detach.test", "volume_id", "detach_volume"),
+					resource.TestCheckResourceAttrSet("nomad_csi_volume_detach.test", "node_id"),
+					resource.TestCheckResourceAttrSet("nomad_csi_volume_detach.test", "id"),
+				),
+			},
+			{
+				// Changing the triggers detaches the volume again.
+				Config:          testCSIVolumeDetachConfig("2"),
+				ConfigVariables: vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_csi_volume_detach.test", "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCSIVolumeDetachConfig(run string) string {
+	return fmt.Sprintf(`
+variable "node_id" {
+  type = string
+}
+
+resource "nomad_csi_volume" "test" {
+  plugin_id    = "hostpath-plugin0"
+  volume_id    = "detach_volume"
+  name         = "detach_volume"
+  capacity_min = "1GiB"
+  capacity_max = "2GiB"
+
+  capability {
+    access_mode     = "single-node-writer"
+    attachment_mode = "file-system"
+  }
+}
+
+resource "nomad_csi_volume_detach" "test" {
+  volume_id = nomad_csi_volume.test.volume_id
+  node_id   = var.node_id
+
+  triggers = {
+    run = %q
+  }
+}
+`, run)
+}
@@ -64,6 +64,9 @@ type csiVolumeRegistrationModel struct {
 	NodesExpected         types.Int64  `tfsdk:"nodes_expected"`
 	Schedulable           types.Bool   `tfsdk:"schedulable"`
 	Topologies            types.List   `tfsdk:"topologies"`
+	ReadAllocations       types.List   `tfsdk:"read_allocations"`
+	WriteAllocations      types.List   `tfsdk:"write_allocations"`
+	Claims                types.List   `tfsdk:"claims"`
 }
 
 var (
@@ -577,6 +580,7 @@ func (r *CSIVolumeRegistrationResource) readVolumeIntoModel(ctx context.Context,
 
 	data.Capability = flattenCapabilities(volume.RequestedCapabilities)
 	data.Topologies = flattenTopologiesToList(volume.Topologies)
+	data.ReadAllocations, data.WriteAllocations, data.Claims = flattenClaims(volume)
 	data.Context = stringMapToMapValue(volume.Context)
 
 	if volume.RequestedTopologies != nil {
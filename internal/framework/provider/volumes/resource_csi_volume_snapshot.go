@@ -0,0 +1,365 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/helper"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+type csiVolumeSnapshotModel struct {
+	ID               types.String            `tfsdk:"id"`
+	Namespace        types.String            `tfsdk:"namespace"`
+	PluginID         types.String            `tfsdk:"plugin_id"`
+	SourceVolumeID   types.String            `tfsdk:"source_volume_id"`
+	Name             types.String            `tfsdk:"name"`
+	Parameters       map[string]types.String `tfsdk:"parameters"`
+	Secrets          map[string]types.String `tfsdk:"secrets"`
+	SecretsWO        types.Map               `tfsdk:"secrets_wo"`
+	SecretsWOVersion types.Int64             `tfsdk:"secrets_wo_version"`
+
+	// Computed
+	ExternalSourceVolumeID types.String `tfsdk:"external_source_volume_id"`
+	SizeBytes              types.Int64  `tfsdk:"size_bytes"`
+	CreateTime             types.String `tfsdk:"create_time"`
+	IsReady                types.Bool   `tfsdk:"is_ready"`
+}
+
+var (
+	_ resource.Resource               = &CSIVolumeSnapshotResource{}
+	_ resource.ResourceWithConfigure  = &CSIVolumeSnapshotResource{}
+	_ resource.ResourceWithModifyPlan = &CSIVolumeSnapshotResource{}
+)
+
+type CSIVolumeSnapshotResource struct {
+	providerConfig nomad.ProviderConfig
+}
+
+func NewCSIVolumeSnapshotResource() resource.Resource {
+	return &CSIVolumeSnapshotResource{}
+}
+
+func (r *CSIVolumeSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_csi_volume_snapshot"
+}
+
+func (r *CSIVolumeSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the snapshot, as assigned by the storage provider.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"namespace": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("default"),
+			Description: "The namespace of the source volume.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"plugin_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the CSI plugin that manages the source volume.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_volume_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Nomad CSI volume to snapshot.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The suggested name of the snapshot. The storage provider may ignore it.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"parameters": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "An optional key-value map of strings passed directly to the CSI plugin to configure the snapshot.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"external_source_volume_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the source volume, as known by the storage provider.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"size_bytes": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The size of the snapshot in bytes.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"create_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "The time the snapshot was created, in RFC3339 format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"is_ready": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the snapshot is ready to be used to create a volume.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	for k, v := range secretsAttributes() {
+		attrs[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a snapshot of a CSI volume.",
+		Attributes:  attrs,
+	}
+}
+
+func (r *CSIVolumeSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	metaFunc, ok := req.ProviderData.(func() any)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected func() any, got %T.", req.ProviderData),
+		)
+		return
+	}
+	providerConfig, ok := metaFunc().(nomad.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Meta Type",
+			fmt.Sprintf("Expected nomad.ProviderConfig, got %T.", metaFunc()),
+		)
+		return
+	}
+	r.providerConfig = providerConfig
+}
+
+func (r *CSIVolumeSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configData csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, diags := resolveSecrets(configData.SecretsWO, data.Secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot := &api.CSISnapshot{
+		SourceVolumeID: data.SourceVolumeID.ValueString(),
+		PluginID:       data.PluginID.ValueString(),
+		Name:           data.Name.ValueString(),
+		Secrets:        secrets,
+		Parameters:     toMapStringString(data.Parameters),
+	}
+	opts := &api.WriteOptions{Namespace: data.Namespace.ValueString()}
+
+	tflog.Debug(ctx, "Creating CSI volume snapshot", map[string]any{"volume_id": snapshot.SourceVolumeID, "plugin_id": snapshot.PluginID})
+	snapResp, _, err := r.providerConfig.Client().CSIVolumes().CreateSnapshot(snapshot, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating CSI volume snapshot", err.Error())
+		return
+	}
+	if snapResp == nil || len(snapResp.Snapshots) == 0 || snapResp.Snapshots[0] == nil {
+		resp.Diagnostics.AddError("Error creating CSI volume snapshot", "no snapshot returned by the CSI plugin")
+		return
+	}
+
+	snap := snapResp.Snapshots[0]
+	tflog.Debug(ctx, "Created CSI volume snapshot", map[string]any{"snapshot_id": snap.ID})
+
+	data.ID = types.StringValue(snap.ID)
+	flattenCSISnapshot(snap, &data)
+
+	handleSecretsWOHash(ctx, configData.SecretsWO, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the snapshot by listing the snapshots of the plugin, since
+// Nomad has no endpoint to read a single snapshot. The snapshot is only
+// removed from state when the listing succeeds and doesn't include it, as
+// not all plugins support listing snapshots.
+func (r *CSIVolumeSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+	snap, err := r.findSnapshot(ctx, data.PluginID.ValueString(), id, toMapStringString(data.Secrets))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list CSI volume snapshots, keeping the snapshot in state", map[string]any{"snapshot_id": id, "error": err.Error()})
+		return
+	}
+	if snap == nil {
+		tflog.Debug(ctx, "CSI volume snapshot not found, removing from state", map[string]any{"snapshot_id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only refresh the fields that change while the snapshot is being cut,
+	// plugins don't always populate the others when listing snapshots.
+	data.SizeBytes = types.Int64Value(snap.SizeBytes)
+	data.IsReady = types.BoolValue(snap.IsReady)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only persists changes to the secrets, all the other arguments
+// require the snapshot to be replaced.
+func (r *CSIVolumeSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configData csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	handleSecretsWOHash(ctx, configData.SecretsWO, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the snapshot from the storage provider. Only secrets that
+// are stored in state can be sent, since write-only secrets are not
+// available when the resource is destroyed.
+func (r *CSIVolumeSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot := &api.CSISnapshot{
+		ID:       data.ID.ValueString(),
+		PluginID: data.PluginID.ValueString(),
+		Secrets:  toMapStringString(data.Secrets),
+	}
+
+	tflog.Debug(ctx, "Deleting CSI volume snapshot", map[string]any{"snapshot_id": snapshot.ID, "plugin_id": snapshot.PluginID})
+	err := r.providerConfig.Client().CSIVolumes().DeleteSnapshot(snapshot, &api.WriteOptions{Namespace: data.Namespace.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting CSI volume snapshot", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Deleted CSI volume snapshot", map[string]any{"snapshot_id": snapshot.ID})
+}
+
+func (r *CSIVolumeSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configData csiVolumeSnapshotModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateSecretsVersion := types.Int64Null()
+	if !req.State.Raw.IsNull() {
+		var stateData csiVolumeSnapshotModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateSecretsVersion = stateData.SecretsWOVersion
+	}
+
+	if modifySecretsWOPlan(ctx, req.Private, configData.SecretsWO, configData.SecretsWOVersion, stateSecretsVersion, &plan.SecretsWOVersion, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+// findSnapshot pages through the snapshots of the plugin and returns the one
+// with the given ID, or nil if it doesn't exist.
+func (r *CSIVolumeSnapshotResource) findSnapshot(ctx context.Context, pluginID, id string, secrets map[string]string) (*api.CSISnapshot, error) {
+	req := &api.CSISnapshotListRequest{
+		PluginID:     pluginID,
+		Secrets:      secrets,
+		QueryOptions: *(&api.QueryOptions{}).WithContext(ctx),
+	}
+	for {
+		resp, _, err := r.providerConfig.Client().CSIVolumes().ListSnapshotsOpts(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, snap := range resp.Snapshots {
+			if snap.ID == id {
+				return snap, nil
+			}
+		}
+		if resp.NextToken == "" {
+			return nil, nil
+		}
+		req.NextToken = resp.NextToken
+	}
+}
+
+func flattenCSISnapshot(snap *api.CSISnapshot, data *csiVolumeSnapshotModel) {
+	data.ExternalSourceVolumeID = types.StringValue(snap.ExternalSourceVolumeID)
+	data.SizeBytes = types.Int64Value(snap.SizeBytes)
+	data.CreateTime = helper.FormatTime(time.Unix(snap.CreateTime, 0))
+	data.IsReady = types.BoolValue(snap.IsReady)
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package volumes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-nomad/internal/framework/provider/testutil"
+	"github.com/hashicorp/terraform-provider-nomad/nomad"
+)
+
+func TestResourceCSIVolumeSnapshot_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories(t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testCheckCSIPluginAvailable(t, "hostpath-plugin0")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCSIVolumeSnapshotConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nomad_csi_volume_snapshot.test", "id"),
+					resource.TestCheckResourceAttr("nomad_csi_volume_snapshot.test", "source_volume_id", "snapshot_source"),
+					resource.TestCheckResourceAttr("nomad_csi_volume_snapshot.test", "plugin_id", "hostpath-plugin0"),
+					resource.TestCheckResourceAttrSet("nomad_csi_volume_snapshot.test", "external_source_volume_id"),
+					resource.TestCheckResourceAttrSet("nomad_csi_volume_snapshot.test", "create_time"),
+				),
+			},
+			{
+				Config: testCSIVolumeSnapshotConfig + `
+data "nomad_csi_volume_snapshots" "test" {
+  plugin_id = nomad_csi_volume_snapshot.test.plugin_id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.nomad_csi_volume_snapshots.test", "snapshots.*.id",
+						"nomad_csi_volume_snapshot.test", "id",
+					),
+				),
+			},
+		},
+		CheckDestroy: testCSIVolumeSnapshotCheckDestroy(t),
+	})
+}
+
+const testCSIVolumeSnapshotConfig = `
+resource "nomad_csi_volume" "test" {
+  plugin_id    = "hostpath-plugin0"
+  volume_id    = "snapshot_source"
+  name         = "snapshot_source"
+  capacity_min = "1GiB"
+  capacity_max = "2GiB"
+
+  capability {
+    access_mode     = "single-node-writer"
+    attachment_mode = "file-system"
+  }
+}
+
+resource "nomad_csi_volume_snapshot" "test" {
+  plugin_id        = nomad_csi_volume.test.plugin_id
+  source_volume_id = nomad_csi_volume.test.volume_id
+  name             = "snapshot-test"
+}
+`
+
+func testCSIVolumeSnapshotCheckDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutil.SDKV2ProviderMeta(t)().(nomad.ProviderConfig).Client()
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "nomad_csi_volume_snapshot" {
+				continue
+			}
+
+			resp, _, err := client.CSIVolumes().ListSnapshotsOpts(&api.CSISnapshotListRequest{
+				PluginID: r.Primary.Attributes["plugin_id"],
+			})
+			if err != nil {
+				return fmt.Errorf("error listing CSI volume snapshots: %w", err)
+			}
+			for _, snap := range resp.Snapshots {
+				if snap.ID == r.Primary.ID {
+					return fmt.Errorf("CSI volume snapshot %q still exists", r.Primary.ID)
+				}
+			}
+		}
+		return nil
+	}
+}
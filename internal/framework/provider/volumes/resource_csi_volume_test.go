@@ -121,6 +121,11 @@ resource "nomad_csi_volume" "test" {
 
 					test.Eq(t, "mysql_volume", instanceState.ID)
 
+					// The volume is not used by any allocation.
+					test.Eq(t, "0", instanceState.Attributes["read_allocations.#"])
+					test.Eq(t, "0", instanceState.Attributes["write_allocations.#"])
+					test.Eq(t, "0", instanceState.Attributes["claims.#"])
+
 					providerData := testutil.SDKV2ProviderMeta(t)()
 					providerConfig, ok := providerData.(nomad.ProviderConfig)
 					must.True(t, ok, must.Sprintf("expected nomad.ProviderConfig, got %T", providerData))
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// aclPolicyRuleBlocks are the typed alternatives to rules_hcl.
+var aclPolicyRuleBlocks = []string{
+	"namespace", "host_volume", "variables", "agent", "node", "operator", "quota", "plugin",
+}
+
+var (
+	aclPolicyDispositions = []string{acl.PolicyDeny, acl.PolicyRead, acl.PolicyWrite}
+
+	aclNamespacePolicies = []string{acl.PolicyDeny, acl.PolicyRead, acl.PolicyWrite, acl.PolicyScale}
+
+	aclPluginPolicies = []string{acl.PolicyDeny, acl.PolicyRead, acl.PolicyList, acl.PolicyWrite}
+
+	aclNamespaceCapabilities = []string{
+		acl.NamespaceCapabilityDeny,
+		acl.NamespaceCapabilityListJobs,
+		acl.NamespaceCapabilityParseJob,
+		acl.NamespaceCapabilityReadJob,
+		acl.NamespaceCapabilitySubmitJob,
+		acl.NamespaceCapabilityDispatchJob,
+		acl.NamespaceCapabilityReadLogs,
+		acl.NamespaceCapabilityReadFS,
+		acl.NamespaceCapabilityAllocExec,
+		acl.NamespaceCapabilityAllocNodeExec,
+		acl.NamespaceCapabilityAllocLifecycle,
+		acl.NamespaceCapabilitySentinelOverride,
+		acl.NamespaceCapabilityCSIRegisterPlugin,
+		acl.NamespaceCapabilityCSIWriteVolume,
+		acl.NamespaceCapabilityCSIReadVolume,
+		acl.NamespaceCapabilityCSIListVolume,
+		acl.NamespaceCapabilityCSIMountVolume,
+		acl.NamespaceCapabilityHostVolumeCreate,
+		acl.NamespaceCapabilityHostVolumeRegister,
+		acl.NamespaceCapabilityHostVolumeRead,
+		acl.NamespaceCapabilityHostVolumeWrite,
+		acl.NamespaceCapabilityHostVolumeDelete,
+		acl.NamespaceCapabilityListScalingPolicies,
+		acl.NamespaceCapabilityReadScalingPolicy,
+		acl.NamespaceCapabilityReadJobScaling,
+		acl.NamespaceCapabilityScaleJob,
+		acl.NamespaceCapabilitySubmitRecommendation,
+		acl.NamespaceCapabilityRegisterJob,
+		acl.NamespaceCapabilityRevertJob,
+		acl.NamespaceCapabilityDeregisterJob,
+		acl.NamespaceCapabilityPurgeJob,
+		acl.NamespaceCapabilityEvaluateJob,
+		acl.NamespaceCapabilityPlanJob,
+		acl.NamespaceCapabilityTagJobVersion,
+		acl.NamespaceCapabilityStableJob,
+		acl.NamespaceCapabilityFailDeployment,
+		acl.NamespaceCapabilityPauseDeployment,
+		acl.NamespaceCapabilityPromoteDeployment,
+		acl.NamespaceCapabilityUnblockDeployment,
+		acl.NamespaceCapabilityCancelDeployment,
+		acl.NamespaceCapabilitySetAllocHealthDeployment,
+		acl.NamespaceCapabilityGCAllocation,
+		acl.NamespaceCapabilityPauseAllocation,
+		acl.NamespaceCapabilityForcePeriodicJob,
+		acl.NamespaceCapabilityDeleteServiceRegistration,
+	}
+
+	aclHostVolumeCapabilities = []string{
+		acl.HostVolumeCapabilityDeny,
+		acl.HostVolumeCapabilityMountReadOnly,
+		acl.HostVolumeCapabilityMountReadWrite,
+	}
+
+	aclVariablesCapabilities = []string{
+		acl.VariablesCapabilityList,
+		acl.VariablesCapabilityRead,
+		acl.VariablesCapabilityWrite,
+		acl.VariablesCapabilityDestroy,
+		acl.VariablesCapabilityDeny,
+	}
+
+	aclOperatorCapabilities = []string{
+		acl.OperatorCapabilityDeny,
+		acl.OperatorCapabilitySnapshotSave,
+		acl.OperatorCapabilityLicenseRead,
+		acl.OperatorCapabilityKeyringRotate,
+		acl.OperatorCapabilityKeyringRead,
+		acl.OperatorCapabilityKeyringDelete,
+	}
+)
+
+// ACLNamespaceCapabilities returns the capabilities that can be granted in a
+// namespace, for the nomad_acl_policy_check data source of the framework
+// provider.
+func ACLNamespaceCapabilities() []string {
+	return slices.Clone(aclNamespaceCapabilities)
+}
+
+func aclCapabilitiesSchema(description string, capabilities []string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeSet,
+		Required:    required,
+		Optional:    !required,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(capabilities, false),
+		},
+	}
+}
+
+func aclDispositionBlockSchema(description string, policies []string) *schema.Schema {
+	return &schema.Schema{
+		Description:   description,
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"rules_hcl"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy": {
+					Description:  "The policy to apply.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(policies, false),
+				},
+			},
+		},
+	}
+}
+
+// aclPolicyRuleSchemas returns the schemas of the typed rule blocks.
+func aclPolicyRuleSchemas() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace": {
+			Description:   "The rules of a namespace.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"rules_hcl"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the namespace, may contain wildcards.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"policy": {
+						Description:  "The coarse-grained policy of the namespace.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(aclNamespacePolicies, false),
+					},
+					"capabilities": aclCapabilitiesSchema("The fine-grained capabilities granted in the namespace.", aclNamespaceCapabilities, false),
+				},
+			},
+		},
+		"host_volume": {
+			Description:   "The rules of a host volume.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"rules_hcl"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the host volume, may contain wildcards.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"policy": {
+						Description:  "The coarse-grained policy of the host volume.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(aclPolicyDispositions, false),
+					},
+					"capabilities": aclCapabilitiesSchema("The fine-grained capabilities granted on the host volume.", aclHostVolumeCapabilities, false),
+				},
+			},
+		},
+		"variables": {
+			Description:   "The rules of a variables path.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"rules_hcl"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"namespace": {
+						Description: "The namespace of the variables.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "default",
+					},
+					"path": {
+						Description: "The path of the variables, may contain wildcards.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"capabilities": aclCapabilitiesSchema("The capabilities granted on the variables.", aclVariablesCapabilities, true),
+				},
+			},
+		},
+		"agent": aclDispositionBlockSchema("The rules of the agent API.", aclPolicyDispositions),
+		"node":  aclDispositionBlockSchema("The rules of the node API.", aclPolicyDispositions),
+		"operator": {
+			Description:   "The rules of the operator API.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"rules_hcl"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy": {
+						Description:  "The coarse-grained policy of the operator API.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(aclPolicyDispositions, false),
+					},
+					"capabilities": aclCapabilitiesSchema("The fine-grained capabilities granted on the operator API.", aclOperatorCapabilities, false),
+				},
+			},
+		},
+		"quota":  aclDispositionBlockSchema("The rules of the quota API.", aclPolicyDispositions),
+		"plugin": aclDispositionBlockSchema("The rules of the CSI plugin API.", aclPluginPolicies),
+	}
+}
+
+// hasACLPolicyRuleBlocks returns whether any of the typed rule blocks is set.
+func hasACLPolicyRuleBlocks(d interface{ Get(string) interface{} }) bool {
+	for _, name := range aclPolicyRuleBlocks {
+		if blocks, ok := d.Get(name).([]interface{}); ok && len(blocks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// aclPolicyRuleBlocksKnown returns whether the rule blocks and all their
+// values are known, such as when they don't reference attributes of
+// resources that are not created yet.
+func aclPolicyRuleBlocksKnown(d *schema.ResourceDiff) bool {
+	config := d.GetRawConfig()
+	for _, name := range aclPolicyRuleBlocks {
+		if !d.NewValueKnown(name) || !config.GetAttr(name).IsWhollyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// renderACLPolicyRules renders the typed rule blocks into the HCL policy
+// format accepted by Nomad.
+func renderACLPolicyRules(d interface{ Get(string) interface{} }) string {
+	type namespaceRules struct {
+		policy       string
+		capabilities []string
+		variables    [][2]string
+	}
+
+	var namespaces []string
+	nsRules := map[string]*namespaceRules{}
+	getNamespace := func(name string) *namespaceRules {
+		if _, ok := nsRules[name]; !ok {
+			namespaces = append(namespaces, name)
+			nsRules[name] = &namespaceRules{}
+		}
+		return nsRules[name]
+	}
+
+	for _, raw := range d.Get("namespace").([]interface{}) {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ns := getNamespace(block["name"].(string))
+		ns.policy = block["policy"].(string)
+		ns.capabilities = append(ns.capabilities, aclCapabilitiesList(block["capabilities"])...)
+	}
+	for _, raw := range d.Get("variables").([]interface{}) {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ns := getNamespace(block["namespace"].(string))
+		caps := renderHCLStringList(aclCapabilitiesList(block["capabilities"]))
+		ns.variables = append(ns.variables, [2]string{block["path"].(string), caps})
+	}
+
+	var b strings.Builder
+	for _, name := range namespaces {
+		ns := nsRules[name]
+		fmt.Fprintf(&b, "namespace %s {\n", strconv.Quote(name))
+		if ns.policy != "" {
+			fmt.Fprintf(&b, "  policy = %s\n", strconv.Quote(ns.policy))
+		}
+		if len(ns.capabilities) > 0 {
+			fmt.Fprintf(&b, "  capabilities = %s\n", renderHCLStringList(ns.capabilities))
+		}
+		if len(ns.variables) > 0 {
+			b.WriteString("  variables {\n")
+			for _, v := range ns.variables {
+				fmt.Fprintf(&b, "    path %s {\n      capabilities = %s\n    }\n", strconv.Quote(v[0]), v[1])
+			}
+			b.WriteString("  }\n")
+		}
+		b.WriteString("}\n\n")
+	}
+
+	for _, raw := range d.Get("host_volume").([]interface{}) {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&b, "host_volume %s {\n", strconv.Quote(block["name"].(string)))
+		if policy := block["policy"].(string); policy != "" {
+			fmt.Fprintf(&b, "  policy = %s\n", strconv.Quote(policy))
+		}
+		if caps := aclCapabilitiesList(block["capabilities"]); len(caps) > 0 {
+			fmt.Fprintf(&b, "  capabilities = %s\n", renderHCLStringList(caps))
+		}
+		b.WriteString("}\n\n")
+	}
+
+	for _, name := range []string{"agent", "node", "operator", "quota", "plugin"} {
+		blocks := d.Get(name).([]interface{})
+		if len(blocks) == 0 {
+			continue
+		}
+		block, _ := blocks[0].(map[string]interface{})
+		fmt.Fprintf(&b, "%s {\n", name)
+		if policy, _ := block["policy"].(string); policy != "" {
+			fmt.Fprintf(&b, "  policy = %s\n", strconv.Quote(policy))
+		}
+		if caps := aclCapabilitiesList(block["capabilities"]); len(caps) > 0 {
+			fmt.Fprintf(&b, "  capabilities = %s\n", renderHCLStringList(caps))
+		}
+		b.WriteString("}\n\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// aclCapabilitiesList returns the sorted capabilities of a set, so the
+// rendered policy doesn't depend on the order of the set.
+func aclCapabilitiesList(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+	if !ok || set == nil {
+		return nil
+	}
+	caps := make([]string, 0, set.Len())
+	for _, c := range set.List() {
+		caps = append(caps, c.(string))
+	}
+	sort.Strings(caps)
+	return caps
+}
+
+func renderHCLStringList(s []string) string {
+	quoted := make([]string, len(s))
+	for i, v := range s {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// aclPolicyRulesEquivalent returns whether two policies grant the same
+// permissions. Both are parsed and normalized, so formatting, ordering and
+// the use of a coarse-grained policy instead of the equivalent capabilities
+// don't matter. If either can't be parsed, they are only equivalent when they
+// are identical.
+func aclPolicyRulesEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+	pa, err := acl.Parse(a, acl.PolicyParseLenient)
+	if err != nil {
+		return false
+	}
+	pb, err := acl.Parse(b, acl.PolicyParseLenient)
+	if err != nil {
+		return false
+	}
+	normalizeACLPolicy(pa)
+	normalizeACLPolicy(pb)
+	return reflect.DeepEqual(pa, pb)
+}
+
+// normalizeACLPolicy sorts and deduplicates the parsed policy in place. The
+// coarse-grained namespace, node pool and host volume policies that acl.Parse
+// expanded into capabilities are cleared so only the resulting capabilities
+// are compared.
+func normalizeACLPolicy(p *acl.Policy) {
+	p.Raw = ""
+	p.ExtraKeysHCL = nil
+
+	sortCaps := func(caps []string) []string {
+		if len(caps) == 0 {
+			return nil
+		}
+		caps = slices.Clone(caps)
+		sort.Strings(caps)
+		return slices.Compact(caps)
+	}
+
+	for _, ns := range p.Namespaces {
+		ns.Policy = ""
+		ns.Capabilities = sortCaps(ns.Capabilities)
+		if ns.Variables != nil {
+			for _, path := range ns.Variables.Paths {
+				path.Capabilities = sortCaps(path.Capabilities)
+			}
+			sort.Slice(ns.Variables.Paths, func(i, j int) bool {
+				return ns.Variables.Paths[i].PathSpec < ns.Variables.Paths[j].PathSpec
+			})
+		}
+	}
+	sort.Slice(p.Namespaces, func(i, j int) bool { return p.Namespaces[i].Name < p.Namespaces[j].Name })
+
+	for _, np := range p.NodePools {
+		np.Policy = ""
+		np.Capabilities = sortCaps(np.Capabilities)
+	}
+	sort.Slice(p.NodePools, func(i, j int) bool { return p.NodePools[i].Name < p.NodePools[j].Name })
+
+	for _, hv := range p.HostVolumes {
+		hv.Policy = ""
+		hv.Capabilities = sortCaps(hv.Capabilities)
+	}
+	sort.Slice(p.HostVolumes, func(i, j int) bool { return p.HostVolumes[i].Name < p.HostVolumes[j].Name })
+
+	// The operator and sentinel policies are kept: NewACL grants privileges
+	// from the policy itself, not only from the expanded capabilities.
+	if p.Operator != nil {
+		p.Operator.Capabilities = sortCaps(p.Operator.Capabilities)
+	}
+	if p.Sentinel != nil {
+		p.Sentinel.Capabilities = sortCaps(p.Sentinel.Capabilities)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shoenig/test/must"
+)
+
+func TestRenderACLPolicyRules(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceACLPolicy().Schema, map[string]interface{}{
+		"name": "test",
+		"namespace": []interface{}{
+			map[string]interface{}{
+				"name":         "default",
+				"policy":       "read",
+				"capabilities": []interface{}{"submit-job", "alloc-exec"},
+			},
+		},
+		"variables": []interface{}{
+			map[string]interface{}{
+				"namespace":    "apps",
+				"path":         "shared/*",
+				"capabilities": []interface{}{"read"},
+			},
+		},
+		"host_volume": []interface{}{
+			map[string]interface{}{
+				"name":   "certs",
+				"policy": "read",
+			},
+		},
+		"plugin": []interface{}{
+			map[string]interface{}{
+				"policy": "list",
+			},
+		},
+	})
+
+	must.True(t, hasACLPolicyRuleBlocks(d))
+	must.Eq(t, `namespace "default" {
+  policy = "read"
+  capabilities = ["alloc-exec", "submit-job"]
+}
+
+namespace "apps" {
+  variables {
+    path "shared/*" {
+      capabilities = ["read"]
+    }
+  }
+}
+
+host_volume "certs" {
+  policy = "read"
+}
+
+plugin {
+  policy = "list"
+}
+`, renderACLPolicyRules(d))
+}
+
+func TestACLPolicyRulesEquivalent(t *testing.T) {
+	cases := []struct {
+		name       string
+		a, b       string
+		equivalent bool
+	}{
+		{
+			name:       "formatting",
+			a:          `namespace "default" { policy = "read" }`,
+			b:          "namespace \"default\" {\n  policy   =   \"read\"\n}\n",
+			equivalent: true,
+		},
+		{
+			name:       "capabilities order",
+			a:          `namespace "default" { capabilities = ["submit-job", "read-logs"] }`,
+			b:          `namespace "default" { capabilities = ["read-logs", "submit-job"] }`,
+			equivalent: true,
+		},
+		{
+			name:       "blocks order",
+			a:          "namespace \"a\" { policy = \"read\" }\nnamespace \"b\" { policy = \"write\" }",
+			b:          "namespace \"b\" { policy = \"write\" }\nnamespace \"a\" { policy = \"read\" }",
+			equivalent: true,
+		},
+		{
+			name:       "policy expanded to capabilities",
+			a:          `host_volume "data" { policy = "write" }`,
+			b:          `host_volume "data" { capabilities = ["mount-readwrite", "mount-readonly"] }`,
+			equivalent: true,
+		},
+		{
+			// The operator policy grants privileges on its own, so it is not
+			// equivalent to the capabilities it expands to.
+			name:       "operator policy and capabilities",
+			a:          `operator { policy = "write" }`,
+			b:          `operator { capabilities = ["license-read", "keyring-read"] }`,
+			equivalent: false,
+		},
+		{
+			name: "json",
+			a:    `node { policy = "read" }`,
+			b:    `{"node": {"policy": "read"}}`,
+			// JSON and HCL policies are decoded the same way.
+			equivalent: true,
+		},
+		{
+			name:       "different policy",
+			a:          `namespace "default" { policy = "read" }`,
+			b:          `namespace "default" { policy = "write" }`,
+			equivalent: false,
+		},
+		{
+			name:       "different block",
+			a:          `agent { policy = "read" }`,
+			b:          `node { policy = "read" }`,
+			equivalent: false,
+		},
+		{
+			name:       "invalid",
+			a:          `namespace "default" { policy = "read" }`,
+			b:          `namespace "default" {`,
+			equivalent: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			must.Eq(t, tc.equivalent, aclPolicyRulesEquivalent(tc.a, tc.b))
+		})
+	}
+}
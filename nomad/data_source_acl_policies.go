@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-nomad/nomad/helper"
 )
 
 func dataSourceAclPolicies() *schema.Resource {
@@ -22,6 +23,11 @@ func dataSourceAclPolicies() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"filter": {
+				Description: "Specifies the expression used to filter the results.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"policies": {
 				Description: "ACL Policies",
 				Type:        schema.TypeList,
@@ -66,6 +72,13 @@ func dataSourceAclPoliciesRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting ACL policies: %#v", err)
 	}
 
+	// Nomad returns all the policies in a single page and does not support
+	// the filter parameter for them, so it is applied here.
+	policies, err = helper.FilterSlice(policies, d.Get("filter").(string))
+	if err != nil {
+		return err
+	}
+
 	d.SetId(id.UniqueId())
 	if err := d.Set("policies", flattenAclPolicies(policies)); err != nil {
 		return fmt.Errorf("error setting policies: %#v", err)
@@ -35,6 +35,14 @@ func TestAccDataSourceNomadAclPolicies_Basic(t *testing.T) {
 					resource.TestMatchResourceAttr(dataSourceName, "policies.1.description", regexp.MustCompile("Terraform ACL Policy tf-acc-test")),
 				),
 			},
+			{
+				Config: `
+data "nomad_acl_policies" "test" {
+	filter = "Description matches \"^Terraform ACL Policy tf-acc-test\""
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "policies.#", "2"),
+			},
 		},
 	})
 	// ACL Policy Resource Clean-up
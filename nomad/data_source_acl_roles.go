@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-nomad/nomad/helper"
 )
 
 func dataSourceACLRoles() *schema.Resource {
@@ -19,6 +20,11 @@ func dataSourceACLRoles() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"filter": {
+				Description: "Specifies the expression used to filter the results.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 
 			"acl_roles": {
 				Type:     schema.TypeList,
@@ -72,6 +78,13 @@ func aclRolesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("failed to list ACL Roles: %v", err)
 	}
 
+	// Nomad returns all the roles in a single page and does not support the
+	// filter parameter for them, so it is applied here.
+	aclRoles, err = helper.FilterSlice(aclRoles, d.Get("filter").(string))
+	if err != nil {
+		return err
+	}
+
 	result := make([]map[string]interface{}, len(aclRoles))
 	for i, aclRole := range aclRoles {
 
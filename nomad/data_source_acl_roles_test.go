@@ -57,3 +57,25 @@ data "nomad_acl_roles" "test" {
   prefix = split("-", nomad_acl_role.test.id)[0]
 }
 `
+
+func TestDataSourceACLRoles_filter(t *testing.T) {
+	resourceName := "data.nomad_acl_roles.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t); testCheckMinVersion(t, "1.4.0-beta.1") },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceACLRolesConfig + `
+data "nomad_acl_roles" "filtered" {
+  filter = "Name == \"${nomad_acl_role.test.name}\""
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nomad_acl_roles.filtered", "acl_roles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.nomad_acl_roles.filtered", "acl_roles.0.id", resourceName, "acl_roles.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -5,10 +5,13 @@ package nomad
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-nomad/nomad/helper"
 )
 
 func dataSourceACLTokens() *schema.Resource {
@@ -20,6 +23,40 @@ func dataSourceACLTokens() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"filter": {
+				Description: "Specifies the expression used to filter the results.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"per_page": {
+				Description:  "The number of tokens to request per page. All the pages are read.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"next_token": {
+				Description: "The token to start listing from.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"reverse": {
+				Description: "Whether to list the tokens in reverse order.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"expiration_state": {
+				Description:  "Only return the tokens in this expiration state: expired, expiring, unexpired or none.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(aclTokenExpirationStates, false),
+			},
+			"expiring_within": {
+				Description:  "The duration in which tokens in the expiring state expire.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "24h",
+				ValidateFunc: helper.ValidateDuration,
+			},
 
 			"acl_tokens": {
 				Type:     schema.TypeList,
@@ -82,19 +119,49 @@ func dataSourceACLTokens() *schema.Resource {
 	}
 }
 
+// aclTokenExpirationStates are the values of expiration_state. An expiring
+// token has not expired yet but expires within expiring_within, an unexpired
+// token has not expired yet or has no expiration.
+var aclTokenExpirationStates = []string{"expired", "expiring", "unexpired", "none"}
+
 func aclTokensDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(ProviderConfig).client
 
 	qOpts := &api.QueryOptions{
-		Prefix: d.Get("prefix").(string),
+		Prefix:    d.Get("prefix").(string),
+		Filter:    d.Get("filter").(string),
+		PerPage:   int32(d.Get("per_page").(int)),
+		NextToken: d.Get("next_token").(string),
+		Reverse:   d.Get("reverse").(bool),
 	}
-	tokens, _, err := client.ACLTokens().List(qOpts)
+
+	expiringWithin, err := time.ParseDuration(d.Get("expiring_within").(string))
 	if err != nil {
-		return fmt.Errorf("error while getting the list of tokens: %v", err)
+		return fmt.Errorf("invalid expiring_within: %v", err)
 	}
+	expirationState := d.Get("expiration_state").(string)
 
-	result := make([]map[string]interface{}, len(tokens))
-	for i, t := range tokens {
+	var tokens []*api.ACLTokenListStub
+	for {
+		log.Printf("[DEBUG] Listing ACL tokens from %q", qOpts.NextToken)
+		page, qm, err := client.ACLTokens().List(qOpts)
+		if err != nil {
+			return fmt.Errorf("error while getting the list of tokens: %v", err)
+		}
+		tokens = append(tokens, page...)
+
+		if qm == nil || qm.NextToken == "" {
+			break
+		}
+		qOpts.NextToken = qm.NextToken
+	}
+
+	now := time.Now()
+	result := make([]map[string]interface{}, 0, len(tokens))
+	for _, t := range tokens {
+		if expirationState != "" && !aclTokenInExpirationState(t.ExpirationTime, expirationState, now, expiringWithin) {
+			continue
+		}
 
 		var expirationTime string
 		if t.ExpirationTime != nil {
@@ -106,7 +173,7 @@ func aclTokensDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			roles[i] = map[string]interface{}{"id": roleLink.ID, "name": roleLink.Name}
 		}
 
-		result[i] = map[string]interface{}{
+		result = append(result, map[string]interface{}{
 			"accessor_id":     t.AccessorID,
 			"name":            t.Name,
 			"type":            t.Type,
@@ -115,9 +182,27 @@ func aclTokensDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			"global":          t.Global,
 			"create_time":     t.CreateTime.String(),
 			"expiration_time": expirationTime,
-		}
+		})
 	}
 
 	d.SetId("nomad-tokens")
 	return d.Set("acl_tokens", result)
 }
+
+// aclTokenInExpirationState returns whether a token with the given expiration
+// time is in the expiration state at now.
+func aclTokenInExpirationState(expirationTime *time.Time, state string, now time.Time, expiringWithin time.Duration) bool {
+	expired := expirationTime != nil && !expirationTime.After(now)
+
+	switch state {
+	case "none":
+		return expirationTime == nil
+	case "expired":
+		return expired
+	case "expiring":
+		return expirationTime != nil && !expired && expirationTime.Before(now.Add(expiringWithin))
+	case "unexpired":
+		return !expired
+	}
+	return false
+}
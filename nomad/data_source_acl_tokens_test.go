@@ -5,8 +5,10 @@ package nomad
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/shoenig/test/must"
 )
 
 func TestDataSourceACLTokens_Basic(t *testing.T) {
@@ -46,3 +48,76 @@ data "nomad_acl_tokens" "test" {
 	prefix = split("-", nomad_acl_token.test.accessor_id)[0]
 }
 `
+
+func TestDataSourceACLTokens_filter(t *testing.T) {
+	resourceName := "data.nomad_acl_tokens.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceACLTokensFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acl_tokens.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "acl_tokens.0.accessor_id", "nomad_acl_token.expiring", "accessor_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "acl_tokens.0.expiration_time"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceACLTokensFilterConfig = `
+resource "nomad_acl_token" "expiring" {
+	name           = "tf-acc-tokens-filter-expiring"
+	type           = "client"
+	policies       = ["dev"]
+	expiration_ttl = "1h"
+}
+
+resource "nomad_acl_token" "unexpiring" {
+	name     = "tf-acc-tokens-filter-unexpiring"
+	type     = "client"
+	policies = ["dev"]
+}
+
+data "nomad_acl_tokens" "test" {
+	filter           = "Name matches \"^tf-acc-tokens-filter-\""
+	per_page         = 1
+	expiration_state = "expiring"
+	expiring_within  = "2h"
+
+	depends_on = [nomad_acl_token.expiring, nomad_acl_token.unexpiring]
+}
+`
+
+func TestACLTokenInExpirationState(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	soon := now.Add(time.Hour)
+	later := now.Add(48 * time.Hour)
+
+	cases := []struct {
+		name           string
+		expirationTime *time.Time
+		expected       []string
+	}{
+		{name: "no expiration", expirationTime: nil, expected: []string{"unexpired", "none"}},
+		{name: "expired", expirationTime: &past, expected: []string{"expired"}},
+		{name: "expiring", expirationTime: &soon, expected: []string{"expiring", "unexpired"}},
+		{name: "unexpired", expirationTime: &later, expected: []string{"unexpired"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var states []string
+			for _, state := range aclTokenExpirationStates {
+				if aclTokenInExpirationState(tc.expirationTime, state, now, 24*time.Hour) {
+					states = append(states, state)
+				}
+			}
+			must.Eq(t, tc.expected, states)
+		})
+	}
+}
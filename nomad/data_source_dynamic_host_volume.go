@@ -159,6 +159,9 @@ func dynamicHostVolumeReadWithCapacity(d *schema.ResourceData, meta any) error {
 
 func dynamicHostVolumeReadImpl(d *schema.ResourceData, meta any, setCapacityStrings bool) error {
 	client := meta.(ProviderConfig).client
+	if err := meta.(ProviderConfig).CheckServerVersion("the dynamic host volume API", "1.10.0"); err != nil {
+		return err
+	}
 
 	ns, id := getDynamicHostVolumeNamespacedID(d)
 	vol, _, err := client.HostVolumes().Get(id, &api.QueryOptions{Namespace: ns})
@@ -6,11 +6,14 @@ package nomad
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-nomad/nomad/helper"
 )
 
 func dataSourceVolumes() *schema.Resource {
@@ -53,6 +56,55 @@ func dataSourceVolumes() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeMap},
 			},
+			"claims": {
+				Description: "The allocations holding a claim on the volumes",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"volume_id": {
+							Description: "The ID of the claimed volume.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"namespace": {
+							Description: "The namespace of the claimed volume.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"allocation_id": {
+							Description: "The ID of the allocation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"node_id": {
+							Description: "The ID of the node the allocation runs on.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"job_id": {
+							Description: "The ID of the job of the allocation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"task_group": {
+							Description: "The task group of the allocation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"client_status": {
+							Description: "The client status of the allocation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mode": {
+							Description: "The mode of the claim, either \"read\" or \"write\".",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -81,6 +133,7 @@ func volumesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading volumes from Nomad: %s", err)
 	}
 	volumes := make([]map[string]interface{}, 0, len(resp))
+	claims := make([]map[string]interface{}, 0)
 	for _, v := range resp {
 		volume := map[string]interface{}{
 			"id":                   v.ID,
@@ -97,11 +150,63 @@ func volumesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			"controllers_expected": strconv.Itoa(v.ControllersExpected),
 			"nodes_healthy":        strconv.Itoa(v.NodesHealthy),
 			"nodes_expected":       strconv.Itoa(v.NodesExpected),
+			"current_readers":      strconv.Itoa(v.CurrentReaders),
+			"current_writers":      strconv.Itoa(v.CurrentWriters),
+			"read_allocations":     "",
+			"write_allocations":    "",
+		}
+
+		// The list stubs only count the claims, so the volume is only read
+		// when it's claimed to avoid a request per volume.
+		if v.CurrentReaders+v.CurrentWriters > 0 {
+			vol, _, err := client.CSIVolumes().Info(v.ID, &api.QueryOptions{Namespace: v.Namespace})
+			if err != nil {
+				return fmt.Errorf("error reading claims of volume %q: %s", v.ID, err)
+			}
+			volume["read_allocations"] = strings.Join(sortedAllocIDs(vol.ReadAllocs), ",")
+			volume["write_allocations"] = strings.Join(sortedAllocIDs(vol.WriteAllocs), ",")
+			claims = append(claims, flattenVolumeClaims(vol)...)
 		}
 		volumes = append(volumes, volume)
 	}
 	log.Printf("[DEBUG] Finished reading volumes from Nomad")
 	d.SetId(client.Address() + "/v1/volumes")
 
-	return d.Set("volumes", volumes)
+	sw := helper.NewStateWriter(d)
+	sw.Set("volumes", volumes)
+	sw.Set("claims", claims)
+	return sw.Error()
+}
+
+func flattenVolumeClaims(vol *api.CSIVolume) []map[string]interface{} {
+	claims := make([]map[string]interface{}, 0, len(vol.Allocations))
+	for _, alloc := range vol.Allocations {
+		if alloc == nil {
+			continue
+		}
+		mode := "read"
+		if _, ok := vol.WriteAllocs[alloc.ID]; ok {
+			mode = "write"
+		}
+		claims = append(claims, map[string]interface{}{
+			"volume_id":     vol.ID,
+			"namespace":     vol.Namespace,
+			"allocation_id": alloc.ID,
+			"node_id":       alloc.NodeID,
+			"job_id":        alloc.JobID,
+			"task_group":    alloc.TaskGroup,
+			"client_status": alloc.ClientStatus,
+			"mode":          mode,
+		})
+	}
+	return claims
+}
+
+func sortedAllocIDs(allocs map[string]*api.Allocation) []string {
+	ids := make([]string, 0, len(allocs))
+	for id := range allocs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
 }
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+)
+
+// Names of the Nomad Enterprise license features required by resources.
+const (
+	entFeatureResourceQuotas   = "Resource Quotas"
+	entFeatureSentinelPolicies = "Sentinel Policies"
+)
+
+// ErrEnterpriseOnlyEndpoint is the error returned by the API client when
+// reading the license of a Nomad community edition cluster. It is exported for
+// the nomad_license data source of the framework provider.
+const ErrEnterpriseOnlyEndpoint = "Nomad Enterprise only endpoint"
+
+// enterpriseFeatureError returns err, annotated with the reason it failed
+// when it was caused by the cluster not running Nomad Enterprise or its
+// license not including feature. Nomad only returns an opaque 501 in both
+// cases, so the license is read to tell them apart.
+func enterpriseFeatureError(client *api.Client, feature string, err error) error {
+	if err == nil || !strings.Contains(err.Error(), "501") {
+		return err
+	}
+
+	resp, _, licenseErr := client.Operator().LicenseGet(nil)
+	if licenseErr != nil {
+		if strings.Contains(licenseErr.Error(), ErrEnterpriseOnlyEndpoint) {
+			return fmt.Errorf("%w: %q requires Nomad Enterprise, but the cluster runs the community edition", err, feature)
+		}
+
+		// The license can't be read, for example because the token doesn't
+		// have the operator:read capability, so keep the original error.
+		log.Printf("[DEBUG] Failed to read the license to diagnose error: %v", licenseErr)
+		return err
+	}
+
+	if resp == nil || resp.License == nil {
+		return err
+	}
+	for _, f := range resp.License.Features {
+		if f == feature {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: the Nomad Enterprise license %q does not include the %q feature", err, resp.License.LicenseID, feature)
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-bexpr"
+)
+
+// FilterSlice returns the items that match the filter expression. It is used
+// for the list endpoints that don't support the filter query parameter, and
+// evaluates the expression the same way Nomad does for the ones that do.
+func FilterSlice[T any](items []T, filter string) ([]T, error) {
+	if filter == "" {
+		return items, nil
+	}
+
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse filter expression %q: %w", filter, err)
+	}
+
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		match, err := eval.Evaluate(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate filter expression %q: %w", filter, err)
+		}
+		if match {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValidateDuration is a schema.SchemaValidateFunc that checks that the value
+// is a duration such as "10s" or "24h".
+func ValidateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as \"10s\" or \"24h\": %v", k, err)}
+	}
+	return nil, nil
+}
@@ -19,6 +19,10 @@ import (
 type ProviderConfig struct {
 	client *api.Client
 	config *api.Config
+
+	// serverVersion caches the version of the Nomad servers, detected on
+	// first use by ServerVersion.
+	serverVersion *serverVersionCache
 }
 
 func Provider() *schema.Provider {
@@ -155,7 +159,6 @@ func Provider() *schema.Provider {
 			"nomad_acl_tokens":          dataSourceACLTokens(),
 			"nomad_allocations":         dataSourceAllocations(),
 			"nomad_datacenters":         dataSourceDatacenters(),
-			"nomad_deployments":         dataSourceDeployments(),
 			"nomad_dynamic_host_volume": dataSourceDynamicHostVolume(),
 			"nomad_job":                 dataSourceJob(),
 			"nomad_job_parser":          dataSourceJobParser(),
@@ -319,8 +322,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	res := ProviderConfig{
-		config: conf,
-		client: client,
+		config:        conf,
+		client:        client,
+		serverVersion: &serverVersionCache{},
 	}
 
 	return res, nil
@@ -4,82 +4,128 @@
 package nomad
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceACLPolicy() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Description: "Unique name for this policy.",
+			Required:    true,
+			Type:        schema.TypeString,
+			ForceNew:    true,
+		},
+
+		"description": {
+			Description: "Description for this policy.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+
+		"rules_hcl": {
+			Description:      "HCL or JSON representation of the rules to enforce on this policy. Use file() to specify a file as input. Computed from the rule blocks when they are used instead.",
+			Optional:         true,
+			Computed:         true,
+			Type:             schema.TypeString,
+			ConflictsWith:    aclPolicyRuleBlocks,
+			DiffSuppressFunc: suppressEquivalentACLPolicyRules,
+		},
+
+		"job_acl": {
+			Description: "Workload identity association that should be applied to the policy.",
+			Optional:    true,
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"namespace": {
+						Description: "Namespace",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "default",
+					},
+					"job_id": {
+						Description: "Job. If empty, the policy applies to all jobs in the namespace.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"group": {
+						Description:  "Group",
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"job_acl.0.job_id"},
+					},
+					"task": {
+						Description:  "Task",
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"job_acl.0.job_id", "job_acl.0.group"},
+					},
+				},
+			},
+		},
+	}
+	for k, v := range aclPolicyRuleSchemas() {
+		s[k] = v
+	}
+
 	return &schema.Resource{
-		Create: resourceACLPolicyCreate,
-		Update: resourceACLPolicyUpdate,
-		Delete: resourceACLPolicyDelete,
-		Read:   resourceACLPolicyRead,
-		Exists: resourceACLPolicyExists,
+		Create:        resourceACLPolicyCreate,
+		Update:        resourceACLPolicyUpdate,
+		Delete:        resourceACLPolicyDelete,
+		Read:          resourceACLPolicyRead,
+		Exists:        resourceACLPolicyExists,
+		CustomizeDiff: resourceACLPolicyCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Description: "Unique name for this policy.",
-				Required:    true,
-				Type:        schema.TypeString,
-				ForceNew:    true,
-			},
+		Schema: s,
+	}
+}
 
-			"description": {
-				Description: "Description for this policy.",
-				Optional:    true,
-				Type:        schema.TypeString,
-			},
+// suppressEquivalentACLPolicyRules ignores changes to rules_hcl that don't
+// change the permissions granted by the policy, such as formatting.
+func suppressEquivalentACLPolicyRules(_, old, new string, _ *schema.ResourceData) bool {
+	return aclPolicyRulesEquivalent(old, new)
+}
 
-			"rules_hcl": {
-				Description: "HCL or JSON representation of the rules to enforce on this policy. Use file() to specify a file as input.",
-				Required:    true,
-				Type:        schema.TypeString,
-			},
+// resourceACLPolicyCustomizeDiff renders the rule blocks into rules_hcl, so
+// the policy is validated at plan time and only updated when the rendered
+// rules grant different permissions than the policy stored in Nomad.
+func resourceACLPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// Unknown values would be rendered as empty strings, so the rules are
+	// only known once the rule blocks are.
+	if !aclPolicyRuleBlocksKnown(d) {
+		return d.SetNewComputed("rules_hcl")
+	}
 
-			"job_acl": {
-				Description: "Workload identity association that should be applied to the policy.",
-				Optional:    true,
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"namespace": {
-							Description: "Namespace",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "default",
-						},
-						"job_id": {
-							Description: "Job. If empty, the policy applies to all jobs in the namespace.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"group": {
-							Description:  "Group",
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"job_acl.0.job_id"},
-						},
-						"task": {
-							Description:  "Task",
-							Type:         schema.TypeString,
-							Optional:     true,
-							RequiredWith: []string{"job_acl.0.job_id", "job_acl.0.group"},
-						},
-					},
-				},
-			},
-		},
+	if !hasACLPolicyRuleBlocks(d) {
+		if d.GetRawConfig().GetAttr("rules_hcl").IsNull() {
+			return fmt.Errorf("one of rules_hcl or a rule block (%s) must be set", strings.Join(aclPolicyRuleBlocks, ", "))
+		}
+		return nil
+	}
+
+	rules := renderACLPolicyRules(d)
+	if _, err := acl.Parse(rules, acl.PolicyParseStrict); err != nil {
+		return fmt.Errorf("invalid ACL policy rules: %s", err)
+	}
+
+	old, _ := d.GetChange("rules_hcl")
+	if !aclPolicyRulesEquivalent(old.(string), rules) {
+		return d.SetNew("rules_hcl", rules)
 	}
+	return nil
 }
 
 func parseWorkloadIdentity(workloadIdentity interface{}) (*api.JobACL, error) {
@@ -6,6 +6,7 @@ package nomad
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -111,6 +112,68 @@ func TestResourceACLPolicy_update(t *testing.T) {
 	})
 }
 
+func TestResourceACLPolicy_ruleBlocks(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-nomad-test")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceACLPolicy_ruleBlocksConfig(name, `["submit-job", "read-logs"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testResourceACLPolicy_checkExists(name),
+					resource.TestCheckResourceAttr("nomad_acl_policy.test", "namespace.0.capabilities.#", "2"),
+					resource.TestCheckResourceAttrWith("nomad_acl_policy.test", "rules_hcl", func(rules string) error {
+						if !strings.Contains(rules, `capabilities = ["read-logs", "submit-job"]`) {
+							return fmt.Errorf("unexpected rules_hcl:\n%s", rules)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// Reordering the capabilities doesn't change the policy.
+				Config:   testResourceACLPolicy_ruleBlocksConfig(name, `["read-logs", "submit-job"]`),
+				PlanOnly: true,
+			},
+			{
+				Config:      testResourceACLPolicy_ruleBlocksConfig(name, `["submit-jobs"]`),
+				ExpectError: regexp.MustCompile(`expected namespace.0.capabilities.\d+ to be one of`),
+			},
+		},
+
+		CheckDestroy: testResourceACLPolicy_checkDestroy(name),
+	})
+}
+
+func testResourceACLPolicy_ruleBlocksConfig(name, capabilities string) string {
+	return fmt.Sprintf(`
+resource "nomad_acl_policy" "test" {
+  name        = %q
+  description = "A Terraform acctest ACL policy"
+
+  namespace {
+    name         = "default"
+    policy       = "read"
+    capabilities = %s
+  }
+
+  variables {
+    path         = "apps/*"
+    capabilities = ["read"]
+  }
+
+  node {
+    policy = "read"
+  }
+
+  operator {
+    capabilities = ["keyring-read"]
+  }
+}
+`, name, capabilities)
+}
+
 func TestResourceACLPolicy_namespaceOnlyJobACL(t *testing.T) {
 	name := acctest.RandomWithPrefix("tf-nomad-test")
 	resource.Test(t, resource.TestCase{
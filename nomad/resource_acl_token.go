@@ -4,13 +4,20 @@
 package nomad
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceACLToken() *schema.Resource {
@@ -105,6 +112,68 @@ func resourceACLToken() *schema.Resource {
 				Computed:    true,
 				Type:        schema.TypeString,
 			},
+			"omit_secret_id": {
+				Description: "Don't store the secret of the token in secret_id, so only the accessor ID is stored in the Terraform state.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     false,
+			},
+			"secret_variable": {
+				Description: "Write the secret of the token to a Nomad variable when the token is created.",
+				Optional:    true,
+				ForceNew:    true,
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Description: "The namespace of the variable.",
+							Optional:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+							Default:     api.DefaultNamespace,
+						},
+						"path": {
+							Description: "The path of the variable.",
+							Required:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+						},
+						"key": {
+							Description: "The item of the variable to write the secret to.",
+							Optional:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+							Default:     "secret_id",
+						},
+					},
+				},
+			},
+			"secret_file": {
+				Description: "Write the secret of the token to a local file when the token is created.",
+				Optional:    true,
+				ForceNew:    true,
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Description: "The path of the file.",
+							Required:    true,
+							ForceNew:    true,
+							Type:        schema.TypeString,
+						},
+						"file_permission": {
+							Description:  "The permissions of the file, as an octal string.",
+							Optional:     true,
+							ForceNew:     true,
+							Type:         schema.TypeString,
+							Default:      "0600",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^0?[0-7]{3}$`), "must be an octal file mode such as \"0600\""),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -127,6 +196,16 @@ func resourceACLTokenCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Created ACL token %q", resp.AccessorID)
 	d.SetId(resp.AccessorID)
 
+	// The secret is only returned when the token is created, so it must be
+	// written now. A failure leaves the resource tainted so the token is
+	// replaced by the next apply.
+	if err := writeACLTokenSecretVariable(d, client, resp.SecretID); err != nil {
+		return err
+	}
+	if err := writeACLTokenSecretFile(d, resp.SecretID); err != nil {
+		return err
+	}
+
 	return resourceACLTokenRead(d, meta)
 }
 
@@ -155,6 +234,16 @@ func resourceACLTokenDelete(d *schema.ResourceData, meta interface{}) error {
 	client := providerConfig.client
 	accessor := d.Id()
 
+	// The secret is read before the token is deleted so the secret_variable
+	// and secret_file copies are only removed while they still hold it. With
+	// create_before_destroy they already hold the secret of the replacement.
+	var secret string
+	_, hasVariable := d.GetOk("secret_variable.0")
+	_, hasFile := d.GetOk("secret_file.0")
+	if hasVariable || hasFile {
+		secret = readACLTokenSecret(client, accessor)
+	}
+
 	// delete the token
 	log.Printf("[DEBUG] Deleting ACL token %q", accessor)
 	_, err := client.ACLTokens().Delete(accessor, nil)
@@ -163,9 +252,30 @@ func resourceACLTokenDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Deleted ACL token %q", accessor)
 
+	if secret == "" {
+		return nil
+	}
+	if err := deleteACLTokenSecretVariable(d, client, secret); err != nil {
+		return err
+	}
+	if err := deleteACLTokenSecretFile(d, secret); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// readACLTokenSecret returns the secret of the token, which is not stored in
+// the state when omit_secret_id is set, or "" if it can't be read.
+func readACLTokenSecret(client *api.Client, accessor string) string {
+	token, _, err := client.ACLTokens().Info(accessor, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read the secret of ACL token %q: %s", accessor, err)
+		return ""
+	}
+	return token.SecretID
+}
+
 func resourceACLTokenRead(d *schema.ResourceData, meta interface{}) error {
 	providerConfig := meta.(ProviderConfig)
 	client := providerConfig.client
@@ -191,7 +301,11 @@ func resourceACLTokenRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("accessor_id", token.AccessorID)
-	d.Set("secret_id", token.SecretID)
+	if d.Get("omit_secret_id").(bool) {
+		d.Set("secret_id", "")
+	} else {
+		d.Set("secret_id", token.SecretID)
+	}
 	d.Set("name", token.Name)
 	d.Set("type", token.Type)
 	d.Set("policies", token.Policies)
@@ -260,3 +374,148 @@ func resourceACLTokenGenerate(d *schema.ResourceData) (*api.ACLToken, error) {
 
 	return &token, nil
 }
+
+// writeACLTokenSecretVariable writes the secret of the token to the item of
+// the variable configured in secret_variable, keeping the other items of the
+// variable if it already exists.
+func writeACLTokenSecretVariable(d *schema.ResourceData, client *api.Client, secret string) error {
+	raw, ok := d.GetOk("secret_variable.0")
+	if !ok {
+		return nil
+	}
+	cfg := raw.(map[string]interface{})
+	ns := cfg["namespace"].(string)
+	path := cfg["path"].(string)
+	key := cfg["key"].(string)
+
+	log.Printf("[DEBUG] Writing the secret of ACL token %q to variable %s@%s", d.Id(), path, ns)
+	variable, _, err := client.Variables().Peek(path, &api.QueryOptions{Namespace: ns})
+	if err != nil {
+		return fmt.Errorf("error reading variable %s@%s: %s", path, ns, err)
+	}
+
+	if variable == nil {
+		variable = &api.Variable{
+			Namespace: ns,
+			Path:      path,
+			Items:     api.VariableItems{key: secret},
+		}
+		_, _, err = client.Variables().CheckedCreate(variable, &api.WriteOptions{Namespace: ns})
+	} else {
+		if variable.Items == nil {
+			variable.Items = api.VariableItems{}
+		}
+		variable.Items[key] = secret
+		_, _, err = client.Variables().CheckedUpdate(variable, &api.WriteOptions{Namespace: ns})
+	}
+	if err != nil {
+		return fmt.Errorf("error writing the secret of ACL token %q to variable %s@%s: %s", d.Id(), path, ns, err)
+	}
+	return nil
+}
+
+// deleteACLTokenSecretVariable removes the secret of the token from the
+// variable configured in secret_variable, and deletes the variable if the
+// secret was its only item. The item is left alone if it holds another secret.
+func deleteACLTokenSecretVariable(d *schema.ResourceData, client *api.Client, secret string) error {
+	raw, ok := d.GetOk("secret_variable.0")
+	if !ok {
+		return nil
+	}
+	cfg := raw.(map[string]interface{})
+	ns := cfg["namespace"].(string)
+	path := cfg["path"].(string)
+	key := cfg["key"].(string)
+
+	variable, _, err := client.Variables().Peek(path, &api.QueryOptions{Namespace: ns})
+	if err != nil {
+		return fmt.Errorf("error reading variable %s@%s: %s", path, ns, err)
+	}
+	if variable == nil {
+		return nil
+	}
+	if variable.Items[key] != secret {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing the secret of ACL token %q from variable %s@%s", d.Id(), path, ns)
+	delete(variable.Items, key)
+	if len(variable.Items) == 0 {
+		_, err = client.Variables().CheckedDelete(path, variable.ModifyIndex, &api.WriteOptions{Namespace: ns})
+	} else {
+		_, _, err = client.Variables().CheckedUpdate(variable, &api.WriteOptions{Namespace: ns})
+	}
+	if err != nil {
+		return fmt.Errorf("error removing the secret of ACL token %q from variable %s@%s: %s", d.Id(), path, ns, err)
+	}
+	return nil
+}
+
+// writeACLTokenSecretFile writes the secret of the token to the file
+// configured in secret_file. The secret is written to a temporary file that
+// is only readable by its owner and then renamed over the target, so it is
+// never readable with the permissions of an existing file.
+func writeACLTokenSecretFile(d *schema.ResourceData, secret string) error {
+	raw, ok := d.GetOk("secret_file.0")
+	if !ok {
+		return nil
+	}
+	cfg := raw.(map[string]interface{})
+	path := cfg["path"].(string)
+
+	// The permission has already been validated by the schema.
+	perm, _ := strconv.ParseUint(cfg["file_permission"].(string), 8, 32)
+
+	log.Printf("[DEBUG] Writing the secret of ACL token %q to %q", d.Id(), path)
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("error writing the secret of ACL token %q to %q: %s", d.Id(), path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(secret)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing the secret of ACL token %q to %q: %s", d.Id(), path, err)
+	}
+
+	// CreateTemp uses 0600, the configured permissions are set explicitly
+	// so they don't depend on the umask.
+	if err := os.Chmod(tmp.Name(), fs.FileMode(perm)); err != nil {
+		return fmt.Errorf("error setting the permissions of %q: %s", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error writing the secret of ACL token %q to %q: %s", d.Id(), path, err)
+	}
+	return nil
+}
+
+// deleteACLTokenSecretFile deletes the file configured in secret_file, unless
+// it holds another secret.
+func deleteACLTokenSecretFile(d *schema.ResourceData, secret string) error {
+	raw, ok := d.GetOk("secret_file.0")
+	if !ok {
+		return nil
+	}
+	path := raw.(map[string]interface{})["path"].(string)
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+	if string(content) != secret {
+		log.Printf("[DEBUG] Not deleting %q, it holds the secret of another token", path)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting %q", path)
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting %q: %s", path, err)
+	}
+	return nil
+}
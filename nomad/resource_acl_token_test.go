@@ -6,6 +6,9 @@ package nomad
 import (
 	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -101,6 +104,93 @@ func TestResourceACLToken_RoleLink(t *testing.T) {
 	})
 }
 
+func TestResourceACLToken_omitSecretID(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "token")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t); testCheckMinVersion(t, "1.4.0-beta.1") },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceACLToken_omitSecretIDConfig(secretFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nomad_acl_token.test", "secret_id", ""),
+					resource.TestCheckResourceAttrSet("nomad_acl_token.test", "accessor_id"),
+					testResourceACLToken_checkSecretWritten("nomad_acl_token.test", secretFile),
+				),
+			},
+		},
+
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testResourceACLTokenCheckDestroy,
+			func(*terraform.State) error {
+				if _, err := os.Stat(secretFile); !errors.Is(err, fs.ErrNotExist) {
+					return fmt.Errorf("expected %q to be deleted, got %v", secretFile, err)
+				}
+				return nil
+			},
+		),
+	})
+}
+
+func testResourceACLToken_omitSecretIDConfig(secretFile string) string {
+	return fmt.Sprintf(`
+resource "nomad_acl_token" "test" {
+  name           = "Terraform Test Token"
+  type           = "client"
+  policies       = ["dev"]
+  omit_secret_id = true
+
+  secret_variable {
+    path = "terraform/acl-token-test"
+  }
+
+  secret_file {
+    path = %q
+  }
+}
+`, secretFile)
+}
+
+// testResourceACLToken_checkSecretWritten checks that the secret of the token
+// was written to the variable and file of the resource.
+func testResourceACLToken_checkSecretWritten(name, secretFile string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(ProviderConfig).client
+		accessorID := s.RootModule().Resources[name].Primary.ID
+
+		token, _, err := client.ACLTokens().Info(accessorID, nil)
+		if err != nil {
+			return fmt.Errorf("error reading ACL token %q: %s", accessorID, err)
+		}
+
+		variable, _, err := client.Variables().Read("terraform/acl-token-test", nil)
+		if err != nil {
+			return fmt.Errorf("error reading variable: %s", err)
+		}
+		if variable.Items["secret_id"] != token.SecretID {
+			return errors.New("variable doesn't hold the secret of the token")
+		}
+
+		content, err := os.ReadFile(secretFile)
+		if err != nil {
+			return err
+		}
+		if string(content) != token.SecretID {
+			return errors.New("file doesn't hold the secret of the token")
+		}
+
+		info, err := os.Stat(secretFile)
+		if err != nil {
+			return err
+		}
+		if info.Mode().Perm() != 0o600 {
+			return fmt.Errorf("expected file permissions 0600, got %s", info.Mode().Perm())
+		}
+		return nil
+	}
+}
+
 func testResourceACLToken_initialConfig() string {
 	return `
 resource "nomad_acl_token" "test" {
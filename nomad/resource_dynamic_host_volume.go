@@ -175,6 +175,9 @@ func resourceDynamicHostVolume() *schema.Resource {
 
 func resourceDynamicHostVolumeWrite(d *schema.ResourceData, meta any) error {
 	client := meta.(ProviderConfig).client
+	if err := meta.(ProviderConfig).CheckServerVersion("the dynamic host volume API", "1.10.0"); err != nil {
+		return err
+	}
 
 	parameters := make(map[string]string)
 	for name, value := range d.Get("parameters").(map[string]any) {
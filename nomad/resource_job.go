@@ -812,8 +812,9 @@ func resourceJobRead(_ context.Context, d *schema.ResourceData, meta interface{}
 
 	// Update jobspec submission data if available.
 	// Safely ignore errors as this is an optional step.
-	sub, _, err := client.Jobs().Submission(*job.ID, int(*job.Version), opts)
-	if err != nil {
+	if err := meta.(ProviderConfig).CheckServerVersion("the job submission API", "1.6.0"); err != nil {
+		log.Printf("[DEBUG] Skipping job submission: %v", err)
+	} else if sub, _, err := client.Jobs().Submission(*job.ID, int(*job.Version), opts); err != nil {
 		log.Printf("[WARN] failed to read job submission: %v", err)
 	} else {
 		err := resourceJobReadSubmission(sub, d, meta)
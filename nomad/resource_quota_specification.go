@@ -190,6 +190,7 @@ func resourceQuotaSpecificationWrite(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Upserting quota specification %q", spec.Name)
 	_, err = client.Quotas().Register(&spec, nil)
 	if err != nil {
+		err = enterpriseFeatureError(client, entFeatureResourceQuotas, err)
 		return fmt.Errorf("error upserting quota specification %q: %s", spec.Name, err.Error())
 	}
 	log.Printf("[DEBUG] Upserted quota specification %q", spec.Name)
@@ -241,6 +242,7 @@ func resourceQuotaSpecificationRead(d *schema.ResourceData, meta interface{}) er
 	spec, _, err := client.Quotas().Info(name, nil)
 	if err != nil {
 		// we have Exists, so no need to handle 404
+		err = enterpriseFeatureError(client, entFeatureResourceQuotas, err)
 		return fmt.Errorf("error reading quota specification %q: %s", name, err.Error())
 	}
 	log.Printf("[DEBUG] Read quota specification %q", name)
@@ -268,7 +270,8 @@ func resourceQuotaSpecificationExists(d *schema.ResourceData, meta interface{})
 			return false, nil
 		}
 
-		return true, fmt.Errorf("error checking for quota specification %q: %#v", name, err)
+		err = enterpriseFeatureError(client, entFeatureResourceQuotas, err)
+		return true, fmt.Errorf("error checking for quota specification %q: %s", name, err)
 	}
 	// just to be safe
 	if resp == nil {
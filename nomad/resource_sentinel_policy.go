@@ -84,6 +84,7 @@ func resourceSentinelPolicyWrite(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Creating Sentinel policy %q", policy.Name)
 	_, err := client.SentinelPolicies().Upsert(&policy, nil)
 	if err != nil {
+		err = enterpriseFeatureError(client, entFeatureSentinelPolicies, err)
 		return fmt.Errorf("error upserting Sentinel policy %q: %s", policy.Name, err.Error())
 	}
 	log.Printf("[DEBUG] Upserted Sentinel policy %q", policy.Name)
@@ -114,6 +115,7 @@ func resourceSentinelPolicyRead(d *schema.ResourceData, meta interface{}) error
 	policy, _, err := client.SentinelPolicies().Info(name, nil)
 	if err != nil {
 		// we have Exists, so no need to handle 404
+		err = enterpriseFeatureError(client, entFeatureSentinelPolicies, err)
 		return fmt.Errorf("error reading Sentinel policy %q: %s", name, err.Error())
 	}
 	log.Printf("[DEBUG] Read Sentinel policy %q", name)
@@ -140,7 +142,8 @@ func resourceSentinelPolicyExists(d *schema.ResourceData, meta interface{}) (boo
 			return false, nil
 		}
 
-		return true, fmt.Errorf("error checking for Sentinel policy %q: %#v", name, err)
+		err = enterpriseFeatureError(client, entFeatureSentinelPolicies, err)
+		return true, fmt.Errorf("error checking for Sentinel policy %q: %s", name, err)
 	}
 	// just to be safe
 	if resp == nil {
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/nomad/api"
+)
+
+// serverVersionTimeout bounds the time spent detecting the server version,
+// so an unreachable cluster does not delay the request that needs it.
+const serverVersionTimeout = 10 * time.Second
+
+// serverVersionCache detects the version of the Nomad servers the first time
+// it is needed, so configuring the provider and the operations that don't
+// check the version never wait for it.
+type serverVersionCache struct {
+	once    sync.Once
+	version *version.Version
+}
+
+func (c *serverVersionCache) get(client *api.Client) *version.Version {
+	c.once.Do(func() {
+		c.version = detectServerVersion(client)
+	})
+	return c.version
+}
+
+// detectServerVersion returns the lowest version of the alive servers in the
+// region of the agent, since a request may be handled by any of them. It
+// returns nil if the version can't be detected, for example because the
+// token is not allowed to list the members.
+func detectServerVersion(client *api.Client) *version.Version {
+	ctx, cancel := context.WithTimeout(context.Background(), serverVersionTimeout)
+	defer cancel()
+
+	members, err := client.Agent().MembersOpts((&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		log.Printf("[DEBUG] Failed to detect the Nomad server version: %v", err)
+		return nil
+	}
+
+	var lowest *version.Version
+	for _, member := range members.Members {
+		if member.Status != "alive" || member.Tags["region"] != members.ServerRegion {
+			continue
+		}
+		v, err := version.NewVersion(member.Tags["build"])
+		if err != nil {
+			log.Printf("[DEBUG] Failed to parse the version of server %q: %v", member.Name, err)
+			continue
+		}
+		if lowest == nil || v.LessThan(lowest) {
+			lowest = v
+		}
+	}
+
+	if lowest != nil {
+		log.Printf("[DEBUG] Detected Nomad server version %s", lowest)
+	}
+	return lowest
+}
+
+// ServerVersion returns the version of the Nomad servers, detecting it on
+// the first call, or nil if it could not be detected.
+func (p ProviderConfig) ServerVersion() *version.Version {
+	if p.serverVersion == nil {
+		return nil
+	}
+	return p.serverVersion.get(p.client)
+}
+
+// CheckServerVersion returns an error if the Nomad servers are older than
+// min, which is required by feature. No error is returned when the server
+// version is unknown so that the request is still attempted.
+func (p ProviderConfig) CheckServerVersion(feature, min string) error {
+	serverVersion := p.ServerVersion()
+	if serverVersion == nil {
+		return nil
+	}
+
+	minVersion := version.Must(version.NewVersion(min))
+	if serverVersion.Core().LessThan(minVersion) {
+		return fmt.Errorf("%s requires Nomad >= %s, but the cluster runs Nomad %s", feature, min, serverVersion)
+	}
+	return nil
+}
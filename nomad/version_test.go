@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/nomad/api"
+)
+
+func TestProviderConfig_CheckServerVersion(t *testing.T) {
+	cases := []struct {
+		name          string
+		serverVersion string
+		min           string
+		expectErr     bool
+	}{
+		{"unknown version", "", "1.10.0", false},
+		{"same version", "1.10.0", "1.10.0", false},
+		{"newer version", "1.11.2+ent", "1.10.0", false},
+		{"prerelease of minimum", "1.10.0-beta.1", "1.10.0", false},
+		{"older version", "1.9.7", "1.10.0", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := ProviderConfig{serverVersion: &serverVersionCache{}}
+			p.serverVersion.once.Do(func() {
+				if tc.serverVersion != "" {
+					p.serverVersion.version = version.Must(version.NewVersion(tc.serverVersion))
+				}
+			})
+
+			err := p.CheckServerVersion("the test API", tc.min)
+			if tc.expectErr && err == nil {
+				t.Fatal("expected error")
+			}
+			if !tc.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestProviderConfig_ServerVersionDetectedOnce(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(api.ServerMembers{
+			ServerRegion: "global",
+			Members: []*api.AgentMember{
+				{Name: "a", Status: "alive", Tags: map[string]string{"region": "global", "build": "1.11.2"}},
+				{Name: "b", Status: "alive", Tags: map[string]string{"region": "global", "build": "1.10.5"}},
+				{Name: "c", Status: "left", Tags: map[string]string{"region": "global", "build": "1.9.0"}},
+				{Name: "d", Status: "alive", Tags: map[string]string{"region": "europe", "build": "1.8.0"}},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	client, err := api.NewClient(&api.Config{Address: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := ProviderConfig{client: client, serverVersion: &serverVersionCache{}}
+
+	if requests != 0 {
+		t.Fatalf("expected the version not to be detected before it is used, got %d requests", requests)
+	}
+	for range 2 {
+		if v := p.ServerVersion(); v == nil || v.String() != "1.10.5" {
+			t.Fatalf("expected version 1.10.5, got %v", v)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected the version to be detected once, got %d requests", requests)
+	}
+}